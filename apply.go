@@ -0,0 +1,52 @@
+package async
+
+/*
+
+ApplyEach creates a Routine that calls every one of the routines with the same
+arguments, in Parallel mode.
+
+When the new Routine is called, each of the routines is given the arguments
+that it was called with. The arguments from all of the routines are combined
+in the order that the routines were provided, and passed on to the new
+Routine's Done function. Errors are handled the same as Parallel.
+
+For example, to clear one key out of several caches:
+  invalidate := async.ApplyEach(clearMemory, clearRedis, clearCDN)
+
+  invalidate(func(err error, results ...interface{}) {
+    if err != nil {
+      fmt.Printf("Error: %s", err)
+    }
+  }, "user:42")
+
+*/
+func ApplyEach(routines ...Routine) Routine {
+	return applyEach(0, routines)
+}
+
+/*
+
+ApplyEachSeries creates a Routine that calls every one of the routines with
+the same arguments, the same as ApplyEach, but one after another. If there is
+an error, none of the remaining routines are called.
+
+*/
+func ApplyEachSeries(routines ...Routine) Routine {
+	return applyEach(1, routines)
+}
+
+func applyEach(limit int, routines []Routine) Routine {
+	return func(done Done, args ...interface{}) {
+		bound := make([]Routine, 0, len(routines))
+
+		for i := 0; i < len(routines); i++ {
+			bound = append(bound, func(routine Routine) Routine {
+				return func(next Done, _ ...interface{}) {
+					routine(next, args...)
+				}
+			}(routines[i]))
+		}
+
+		ParallelLimit(bound, limit, done)
+	}
+}
@@ -0,0 +1,54 @@
+package async_test
+
+import (
+	"fmt"
+	"github.com/Southern/async"
+	"testing"
+)
+
+func TestApplyEach(t *testing.T) {
+	prefix := func(p string) async.Routine {
+		return func(done async.Done, args ...interface{}) {
+			Status("Called with arguments: %+v", args)
+			done(nil, p+args[0].(string))
+		}
+	}
+
+	Status("Calling ApplyEach")
+	async.ApplyEach(prefix("a:"), prefix("b:"), prefix("c:"))(func(err error, results ...interface{}) {
+		if err != nil {
+			t.Errorf("Unexpected error: %s", err)
+			return
+		}
+
+		if len(results) != 3 || results[0] != "a:key" || results[2] != "c:key" {
+			t.Errorf("Unexpected results: %+v", results)
+		}
+	}, "key")
+}
+
+func TestApplyEachSeries(t *testing.T) {
+	var order []string
+
+	record := func(name string) async.Routine {
+		return func(done async.Done, args ...interface{}) {
+			order = append(order, name+args[0].(string))
+			done(nil)
+		}
+	}
+
+	fail := func(done async.Done, args ...interface{}) {
+		done(fmt.Errorf("Test error"))
+	}
+
+	Status("Calling ApplyEachSeries")
+	async.ApplyEachSeries(record("a:"), fail, record("b:"))(func(err error, results ...interface{}) {
+		if err == nil {
+			t.Errorf("Did not throw an error as expected")
+		}
+	}, "key")
+
+	if len(order) != 1 || order[0] != "a:key" {
+		t.Errorf("ApplyEachSeries did not stop when it errored: %+v", order)
+	}
+}
@@ -6,6 +6,12 @@ masses.
 */
 package async
 
+import (
+	"context"
+	"runtime/debug"
+	"sync/atomic"
+)
+
 /*
 
 Done types are used for shorthand definitions of the functions that are
@@ -22,6 +28,12 @@ An example a Done function would be:
     // the args.
   }
 
+A Routine must call its Done function exactly once. Any extra calls are
+reported as ErrDoneCalledTwice, as long as they happen before the callbacks
+have been triggered. Calls that arrive after that have nowhere to be reported
+and are dropped. To protect against a Routine that never calls its Done
+function, wrap it with Timeout.
+
 */
 type Done func(error, ...interface{})
 
@@ -36,5 +48,122 @@ An example of a Routine function would be:
     done(nil, "arg1", "arg2", "arg3")
   }
 
+If a Routine panics before calling its Done function, the panic is recovered
+and sent to the Done function as a *PanicError instead of crashing the
+program.
+
 */
 type Routine func(Done, ...interface{})
+
+/*
+
+call runs a Routine, making sure that done is only ever called once.
+
+Any panic inside of the Routine is recovered and turned into a *PanicError,
+so that it is handled the same as any other error. If the Routine calls its
+Done function more than once, or panics after calling it, there is nowhere
+left to send the error through done, so it is given to late instead. Extra
+calls to the Done function are reported as ErrDoneCalledTwice.
+
+*/
+func call(routine Routine, done Done, late func(error), args ...interface{}) {
+	var finished int32
+
+	defer func() {
+		if v := recover(); v != nil {
+			err := &PanicError{Value: v, Stack: debug.Stack()}
+			if atomic.CompareAndSwapInt32(&finished, 0, 1) {
+				done(err)
+				return
+			}
+			late(err)
+		}
+	}()
+
+	routine(func(err error, args ...interface{}) {
+		if !atomic.CompareAndSwapInt32(&finished, 0, 1) {
+			late(ErrDoneCalledTwice)
+			return
+		}
+		done(err, args...)
+	}, args...)
+}
+
+// outcome holds the arguments that a Routine passed to its Done function.
+type outcome struct {
+	err  error
+	args []interface{}
+}
+
+// await runs a Routine and waits for it to call its Done function. Any error
+// that arrives on late first, such as from an earlier Routine calling its Done
+// function twice, is returned instead, as is the error from ctx if it is
+// cancelled while waiting.
+func await(ctx context.Context, routine Routine, late chan error, args ...interface{}) ([]interface{}, error) {
+	result := make(chan outcome, 1)
+
+	go call(routine, func(err error, args ...interface{}) {
+		result <- outcome{err, args}
+	}, func(err error) {
+		select {
+		case late <- err:
+		default:
+		}
+	}, args...)
+
+	select {
+	case o := <-result:
+		return o.args, o.err
+	case err := <-late:
+		return nil, err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// protect runs fn, turning any panic inside of it into a *PanicError.
+func protect(fn func() error) (err error) {
+	defer func() {
+		if v := recover(); v != nil {
+			err = &PanicError{Value: v, Stack: debug.Stack()}
+		}
+	}()
+
+	return fn()
+}
+
+/*
+
+ContextRoutine types are Routine functions that also receive a
+context.Context. They are used by the Context variants of Parallel, Series,
+SeriesParallel and Waterfall, which cancel the context as soon as one of the
+routines returns an error or the caller cancels the parent context.
+
+An example of a ContextRoutine function would be:
+  func MyRoutine(ctx context.Context, done async.Done, args ...interface{}) {
+    select {
+    case <-time.After(time.Second):
+      done(nil, "arg1")
+    case <-ctx.Done():
+      done(ctx.Err())
+    }
+  }
+
+*/
+type ContextRoutine func(context.Context, Done, ...interface{})
+
+// withContext binds ctx to each ContextRoutine so that it can be ran as a
+// normal Routine.
+func withContext(ctx context.Context, routines []ContextRoutine) []Routine {
+	bound := make([]Routine, 0, len(routines))
+
+	for i := 0; i < len(routines); i++ {
+		bound = append(bound, func(routine ContextRoutine) Routine {
+			return func(done Done, args ...interface{}) {
+				routine(ctx, done, args...)
+			}
+		}(routines[i]))
+	}
+
+	return bound
+}
@@ -0,0 +1,246 @@
+package async
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+/*
+
+Task is used by Auto to describe a named Routine and the names of the other
+tasks that it requires.
+
+The Routine is called with a single argument, a map[string][]interface{}
+holding the arguments that each of the required tasks passed to its Done
+function.
+
+*/
+type Task struct {
+	Requires []string
+	Routine  Routine
+}
+
+/*
+
+Auto runs a set of named tasks, starting each Task as soon as all of the tasks
+that it requires have finished. Tasks that don't depend on each other are ran
+in parallel mode.
+
+Before anything is ran, the tasks are checked for requirements that don't
+exist and for cycles. Either of these will trigger the callbacks with an
+error wrapping ErrMissingDependency or ErrDependencyCycle.
+
+If there is an error, no more tasks will be started and the callbacks are
+triggered with the error straight away. Any tasks that are already running are
+still waited on before Auto returns. Otherwise, the callbacks are given a
+single map[string][]interface{} holding the arguments of every Task.
+
+For example:
+  async.Auto(map[string]async.Task{
+    "config": {
+      Routine: func(done async.Done, args ...interface{}) {
+        done(nil, loadConfig())
+      },
+    },
+    "db": {
+      Requires: []string{"config"},
+      Routine: func(done async.Done, args ...interface{}) {
+        config := args[0].(map[string][]interface{})["config"][0].(Config)
+        done(nil, connect(config))
+      },
+    },
+    "cache": {
+      Requires: []string{"config"},
+      Routine:  startCache,
+    },
+  }, func(err error, results ...interface{}) {
+    if err != nil {
+      fmt.Printf("Error: %s", err)
+      return
+    }
+
+    fmt.Printf("Started: %+v", results[0])
+  })
+
+*/
+func Auto(tasks map[string]Task, callbacks ...Done) {
+	var (
+		mutex   sync.Mutex
+		wait    sync.WaitGroup
+		settled bool
+		results = make(map[string][]interface{})
+
+		// pending is the number of requirements each task is waiting on, and
+		// dependents are the tasks that are waiting on each task.
+		pending    = make(map[string]int)
+		dependents = make(map[string][]string)
+
+		final = func(err error, results ...interface{}) {
+			for i := 0; i < len(callbacks); i++ {
+				callbacks[i](err, results...)
+			}
+		}
+
+		// settle makes sure that the callbacks are only ever triggered once,
+		// whether that's from an error or the final results.
+		settle = func() bool {
+			mutex.Lock()
+			defer mutex.Unlock()
+
+			if settled {
+				return false
+			}
+			settled = true
+			return true
+		}
+
+		fail = func(err error) {
+			if settle() {
+				final(err)
+			}
+		}
+
+		start func(name string, inputs map[string][]interface{})
+	)
+
+	names := make([]string, 0, len(tasks))
+	for name := range tasks {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	if err := checkTasks(tasks, names); err != nil {
+		final(err)
+		return
+	}
+
+	for i := 0; i < len(names); i++ {
+		// A task could list the same requirement more than once, but it
+		// only needs to wait on it once.
+		seen := make(map[string]bool)
+
+		requires := tasks[names[i]].Requires
+		for j := 0; j < len(requires); j++ {
+			if seen[requires[j]] {
+				continue
+			}
+			seen[requires[j]] = true
+
+			pending[names[i]]++
+			dependents[requires[j]] = append(dependents[requires[j]], names[i])
+		}
+	}
+
+	start = func(name string, inputs map[string][]interface{}) {
+		wait.Add(1)
+		go call(tasks[name].Routine, func(err error, args ...interface{}) {
+			defer wait.Done()
+
+			if err != nil {
+				fail(err)
+				return
+			}
+
+			mutex.Lock()
+			if settled {
+				mutex.Unlock()
+				return
+			}
+
+			results[name] = args
+
+			// Work out which of the waiting tasks are now able to run, and
+			// gather the results that they require.
+			ready := make(map[string]map[string][]interface{})
+			for _, dependent := range dependents[name] {
+				pending[dependent]--
+				if pending[dependent] == 0 {
+					inputs := make(map[string][]interface{})
+					for _, required := range tasks[dependent].Requires {
+						inputs[required] = results[required]
+					}
+					ready[dependent] = inputs
+				}
+			}
+			mutex.Unlock()
+
+			for _, dependent := range dependents[name] {
+				if inputs, ok := ready[dependent]; ok {
+					start(dependent, inputs)
+				}
+			}
+		}, fail, inputs)
+	}
+
+	// Find all of the tasks without any requirements before starting them,
+	// since the running tasks change what is pending.
+	roots := make([]string, 0)
+	for i := 0; i < len(names); i++ {
+		if pending[names[i]] == 0 {
+			roots = append(roots, names[i])
+		}
+	}
+
+	for i := 0; i < len(roots); i++ {
+		start(roots[i], make(map[string][]interface{}))
+	}
+
+	wait.Wait()
+
+	if settle() {
+		final(nil, results)
+	}
+}
+
+// checkTasks makes sure that every requirement exists, and that there are no
+// cycles between the tasks.
+func checkTasks(tasks map[string]Task, names []string) error {
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+
+	var (
+		state = make(map[string]int)
+		path  []string
+		visit func(name string) error
+	)
+
+	visit = func(name string) error {
+		switch state[name] {
+		case visited:
+			return nil
+		case visiting:
+			return fmt.Errorf("%w: %s -> %s", ErrDependencyCycle, strings.Join(path, " -> "), name)
+		}
+
+		state[name] = visiting
+		path = append(path, name)
+
+		requires := tasks[name].Requires
+		for i := 0; i < len(requires); i++ {
+			if _, ok := tasks[requires[i]]; !ok {
+				return fmt.Errorf("%w: task %q requires %q", ErrMissingDependency, name, requires[i])
+			}
+
+			if err := visit(requires[i]); err != nil {
+				return err
+			}
+		}
+
+		path = path[:len(path)-1]
+		state[name] = visited
+		return nil
+	}
+
+	for i := 0; i < len(names); i++ {
+		if err := visit(names[i]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,206 @@
+package async_test
+
+import (
+	"errors"
+	"fmt"
+	"github.com/Southern/async"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestAuto(t *testing.T) {
+	var (
+		mutex sync.Mutex
+		order []string
+	)
+
+	record := func(name string) {
+		mutex.Lock()
+		order = append(order, name)
+		mutex.Unlock()
+	}
+
+	Status("Calling Auto")
+	async.Auto(map[string]async.Task{
+		"config": {
+			Routine: func(done async.Done, args ...interface{}) {
+				record("config")
+				done(nil, "config")
+			},
+		},
+		"db": {
+			Requires: []string{"config"},
+			Routine: func(done async.Done, args ...interface{}) {
+				record("db")
+				inputs := args[0].(map[string][]interface{})
+				done(nil, fmt.Sprintf("db(%s)", inputs["config"][0]))
+			},
+		},
+		"cache": {
+			Requires: []string{"config"},
+			Routine: func(done async.Done, args ...interface{}) {
+				record("cache")
+				done(nil, "cache")
+			},
+		},
+		"server": {
+			Requires: []string{"db", "cache"},
+			Routine: func(done async.Done, args ...interface{}) {
+				record("server")
+				inputs := args[0].(map[string][]interface{})
+				done(nil, fmt.Sprintf("server(%s, %s)", inputs["db"][0], inputs["cache"][0]))
+			},
+		},
+	}, func(err error, results ...interface{}) {
+		if err != nil {
+			t.Errorf("Unexpected error: %s", err)
+			return
+		}
+
+		Status("Auto completed with results: %+v", results)
+
+		all := results[0].(map[string][]interface{})
+		if all["server"][0] != "server(db(config), cache)" {
+			t.Errorf("Unexpected results: %+v", all)
+		}
+	})
+
+	if len(order) != 4 || order[0] != "config" || order[3] != "server" {
+		t.Errorf("Tasks ran in the wrong order: %+v", order)
+	}
+}
+
+func TestAutoError(t *testing.T) {
+	Status("Calling Auto")
+	async.Auto(map[string]async.Task{
+		"first": {
+			Routine: func(done async.Done, args ...interface{}) {
+				done(fmt.Errorf("Test error"))
+			},
+		},
+		"second": {
+			Requires: []string{"first"},
+			Routine: func(done async.Done, args ...interface{}) {
+				t.Errorf("Task was started after its requirement errored")
+				done(nil)
+			},
+		},
+	}, func(err error, results ...interface{}) {
+		if err == nil {
+			t.Errorf("Did not throw an error as expected")
+		}
+	})
+}
+
+func TestAutoDuplicateRequires(t *testing.T) {
+	var (
+		mutex sync.Mutex
+		runs  int
+	)
+
+	Status("Calling Auto")
+	async.Auto(map[string]async.Task{
+		"first": {
+			Routine: func(done async.Done, args ...interface{}) {
+				done(nil, "first")
+			},
+		},
+		"second": {
+			Requires: []string{"first", "first"},
+			Routine: func(done async.Done, args ...interface{}) {
+				mutex.Lock()
+				runs++
+				mutex.Unlock()
+				done(nil)
+			},
+		},
+	}, func(err error, results ...interface{}) {
+		if err != nil {
+			t.Errorf("Unexpected error: %s", err)
+		}
+	})
+
+	if runs != 1 {
+		t.Errorf("Expected the task to run once, got %d", runs)
+	}
+}
+
+func TestAutoDoneCalledTwice(t *testing.T) {
+	var (
+		mutex sync.Mutex
+		calls int
+		again = make(chan struct{})
+	)
+
+	Status("Calling Auto")
+	async.Auto(map[string]async.Task{
+		"first": {
+			Routine: func(done async.Done, args ...interface{}) {
+				done(nil)
+
+				// Call done again once Auto has already finished.
+				go func() {
+					defer close(again)
+					time.Sleep(10 * time.Millisecond)
+					done(nil)
+				}()
+			},
+		},
+	}, func(err error, results ...interface{}) {
+		mutex.Lock()
+		calls++
+		mutex.Unlock()
+
+		if err != nil {
+			t.Errorf("Unexpected error: %s", err)
+		}
+	})
+
+	<-again
+
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	if calls != 1 {
+		t.Errorf("Expected the callbacks to be called once, got %d", calls)
+	}
+}
+
+func TestAutoMissingDependency(t *testing.T) {
+	Status("Calling Auto")
+	async.Auto(map[string]async.Task{
+		"first": {
+			Requires: []string{"missing"},
+			Routine: func(done async.Done, args ...interface{}) {
+				t.Errorf("Task was started with a missing requirement")
+				done(nil)
+			},
+		},
+	}, func(err error, results ...interface{}) {
+		if !errors.Is(err, async.ErrMissingDependency) {
+			t.Errorf("Expected %s, got %+v", async.ErrMissingDependency, err)
+		}
+	})
+}
+
+func TestAutoCycle(t *testing.T) {
+	routine := func(done async.Done, args ...interface{}) {
+		t.Errorf("Task was started with a cycle")
+		done(nil)
+	}
+
+	Status("Calling Auto")
+	async.Auto(map[string]async.Task{
+		"first":  {Requires: []string{"third"}, Routine: routine},
+		"second": {Requires: []string{"first"}, Routine: routine},
+		"third":  {Requires: []string{"second"}, Routine: routine},
+	}, func(err error, results ...interface{}) {
+		if !errors.Is(err, async.ErrDependencyCycle) {
+			t.Errorf("Expected %s, got %+v", async.ErrDependencyCycle, err)
+			return
+		}
+
+		Status("Got error: %s", err)
+	})
+}
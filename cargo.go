@@ -0,0 +1,174 @@
+package async
+
+import (
+	"sync"
+	"time"
+)
+
+/*
+
+Cargo is used to collect tasks that are pushed into it, and hand them to a
+worker Routine in batches instead of one at a time. This is useful for work
+that is cheaper to do in bulk, such as writing to a database.
+
+A batch is sent to the worker once it holds payload tasks, or once wait has
+passed since the first task of the batch was pushed, whichever happens first.
+If wait is 0, batches are only sent when they are full or Flush is called.
+Only one batch is worked on at a time, and batches are worked on in the order
+that they were sent.
+
+The worker is called with the data of every task in the batch as its
+arguments. Whatever the worker passes to its Done function is sent to the
+callbacks of every task in the batch.
+
+For example:
+  c := async.NewCargo(func(done async.Done, args ...interface{}) {
+    err := db.InsertMany(args...)
+    done(err)
+  }, 100, time.Second)
+
+  c.Push(row, func(err error, results ...interface{}) {
+    if err != nil {
+      fmt.Printf("Error: %s", err)
+    }
+  })
+
+*/
+type Cargo struct {
+	mutex   sync.Mutex
+	queue   *Queue
+	payload int
+	wait    time.Duration
+	tasks   []*task
+	timer   *time.Timer
+
+	// batches counts the batches that have been taken, so that a timer can
+	// tell whether the batch it was started for has already been sent.
+	batches int
+}
+
+// NewCargo will create a new Cargo instance. If payload is less than 1, each
+// batch will only hold one task.
+func NewCargo(worker Routine, payload int, wait time.Duration) *Cargo {
+	if payload < 1 {
+		payload = 1
+	}
+
+	return &Cargo{
+		payload: payload,
+		wait:    wait,
+		queue: NewQueue(func(done Done, args ...interface{}) {
+			batch := args[0].([]*task)
+
+			data := make([]interface{}, 0, len(batch))
+			for i := 0; i < len(batch); i++ {
+				data = append(data, batch[i].data)
+			}
+
+			worker(done, data...)
+		}, 1),
+	}
+}
+
+/*
+
+Push adds a task to the current batch. The callbacks are triggered once the
+worker has finished with the batch that the task was sent in.
+
+Returns the cargo for chaining commands.
+
+*/
+func (c *Cargo) Push(data interface{}, callbacks ...Done) *Cargo {
+	var batch []*task
+
+	c.mutex.Lock()
+	c.tasks = append(c.tasks, &task{data: data, callbacks: callbacks})
+
+	switch {
+	case len(c.tasks) >= c.payload:
+		batch = c.take()
+	case len(c.tasks) == 1 && c.wait > 0:
+		current := c.batches
+		c.timer = time.AfterFunc(c.wait, func() {
+			c.expire(current)
+		})
+	}
+	c.mutex.Unlock()
+
+	c.send(batch)
+	return c
+}
+
+/*
+
+Flush sends the current batch to the worker straight away, even if it isn't
+full.
+
+Returns the cargo for chaining commands.
+
+*/
+func (c *Cargo) Flush() *Cargo {
+	c.mutex.Lock()
+	batch := c.take()
+	c.mutex.Unlock()
+
+	c.send(batch)
+	return c
+}
+
+// Length returns the number of tasks waiting in the current batch
+func (c *Cargo) Length() int {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	return len(c.tasks)
+}
+
+// Idle returns whether there are no tasks waiting or being worked on
+func (c *Cargo) Idle() bool {
+	return c.Length() == 0 && c.queue.Idle()
+}
+
+// expire sends batch number n once it has waited long enough. Stopping the
+// timer doesn't help when it has already fired, so a batch that was sent in
+// the meantime is left alone rather than flushing the next one early.
+func (c *Cargo) expire(n int) {
+	var batch []*task
+
+	c.mutex.Lock()
+	if c.batches == n {
+		batch = c.take()
+	}
+	c.mutex.Unlock()
+
+	c.send(batch)
+}
+
+// take removes the current batch and stops its timer. The cargo must be
+// locked.
+func (c *Cargo) take() []*task {
+	if c.timer != nil {
+		c.timer.Stop()
+		c.timer = nil
+	}
+	c.batches++
+
+	batch := c.tasks
+	c.tasks = nil
+
+	return batch
+}
+
+// send queues a batch for the worker, and fans its result out to the
+// callbacks of every task in the batch.
+func (c *Cargo) send(batch []*task) {
+	if len(batch) == 0 {
+		return
+	}
+
+	c.queue.Push(batch, func(err error, args ...interface{}) {
+		for i := 0; i < len(batch); i++ {
+			batch[i].finish(err, args...)
+		}
+	})
+}
@@ -0,0 +1,93 @@
+package async_test
+
+import (
+	"fmt"
+	"github.com/Southern/async"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestCargo(t *testing.T) {
+	var (
+		mutex   sync.Mutex
+		batches [][]interface{}
+		wait    sync.WaitGroup
+	)
+
+	Status("Creating cargo")
+	c := async.NewCargo(func(done async.Done, args ...interface{}) {
+		Status("Got batch: %+v", args)
+		mutex.Lock()
+		batches = append(batches, args)
+		mutex.Unlock()
+		done(nil, len(args))
+	}, 3, 0)
+
+	wait.Add(7)
+	for i := 0; i < 7; i++ {
+		c.Push(i, func(err error, results ...interface{}) {
+			defer wait.Done()
+
+			if err != nil {
+				t.Errorf("Unexpected error: %s", err)
+			}
+		})
+	}
+
+	if c.Length() != 1 {
+		t.Errorf("Expected 1 task waiting, got %d", c.Length())
+	}
+
+	c.Flush()
+	wait.Wait()
+
+	if len(batches) != 3 || len(batches[0]) != 3 || len(batches[2]) != 1 {
+		t.Errorf("Unexpected batches: %+v", batches)
+	}
+}
+
+func TestCargoWait(t *testing.T) {
+	results := make(chan []interface{}, 2)
+
+	Status("Creating cargo")
+	c := async.NewCargo(func(done async.Done, args ...interface{}) {
+		done(nil, args...)
+	}, 10, 10*time.Millisecond)
+
+	c.Push("first", func(err error, args ...interface{}) {
+		results <- args
+	}).Push("second", func(err error, args ...interface{}) {
+		results <- args
+	})
+
+	select {
+	case batch := <-results:
+		if len(batch) != 2 || batch[0] != "first" || batch[1] != "second" {
+			t.Errorf("Unexpected batch: %+v", batch)
+		}
+	case <-time.After(time.Second):
+		t.Errorf("Batch was not sent after waiting")
+	}
+}
+
+func TestCargoError(t *testing.T) {
+	var wait sync.WaitGroup
+
+	Status("Creating cargo")
+	c := async.NewCargo(func(done async.Done, args ...interface{}) {
+		done(fmt.Errorf("Test error"))
+	}, 2, 0)
+
+	wait.Add(2)
+	for i := 0; i < 2; i++ {
+		c.Push(i, func(err error, args ...interface{}) {
+			defer wait.Done()
+
+			if err == nil {
+				t.Errorf("Did not throw an error as expected")
+			}
+		})
+	}
+	wait.Wait()
+}
@@ -0,0 +1,57 @@
+package async
+
+/*
+
+Seq joins routines together into a single Routine. When the new Routine is
+called, the routines are ran in Waterfall mode, from first to last. The first
+Routine is given the arguments that the new Routine was called with, and
+whatever the last Routine passes to its Done function is passed on to the
+new Routine's Done function.
+
+Unlike Waterfall, the chain isn't ran straight away, so it can be named and
+used over and over again, including inside of Series, Parallel or another Seq.
+
+For example:
+  normalise := async.Seq(trim, lowercase, validate)
+
+  async.Parallel([]async.Routine{
+    func(done async.Done, args ...interface{}) {
+      normalise(done, " First@Example.com ")
+    },
+    func(done async.Done, args ...interface{}) {
+      normalise(done, "SECOND@example.com")
+    },
+  }, func(err error, results ...interface{}) {
+    fmt.Printf("Emails: %+v", results)
+  })
+
+*/
+func Seq(routines ...Routine) Routine {
+	return func(done Done, args ...interface{}) {
+		// The first routine only hands the arguments to the next one, since
+		// Waterfall doesn't give any arguments to the first Routine.
+		chain := append([]Routine{
+			func(next Done, _ ...interface{}) {
+				next(nil, args...)
+			},
+		}, routines...)
+
+		Waterfall(chain, done)
+	}
+}
+
+/*
+
+Compose joins routines together into a single Routine, the same as Seq, but
+the routines are ran from last to first. This matches how functions are
+composed, so Compose(f, g, h) is the same as f(g(h(args))).
+
+*/
+func Compose(routines ...Routine) Routine {
+	reversed := make([]Routine, 0, len(routines))
+	for i := len(routines) - 1; i >= 0; i-- {
+		reversed = append(reversed, routines[i])
+	}
+
+	return Seq(reversed...)
+}
@@ -0,0 +1,90 @@
+package async_test
+
+import (
+	"fmt"
+	"github.com/Southern/async"
+	"testing"
+)
+
+var (
+	add1 = func(done async.Done, args ...interface{}) {
+		Status("Adding 1 to %+v", args)
+		done(nil, args[0].(int)+1)
+	}
+
+	times2 = func(done async.Done, args ...interface{}) {
+		Status("Multiplying %+v by 2", args)
+		done(nil, args[0].(int)*2)
+	}
+)
+
+func TestSeq(t *testing.T) {
+	Status("Calling Seq")
+	async.Seq(add1, times2)(func(err error, results ...interface{}) {
+		if err != nil {
+			t.Errorf("Unexpected error: %s", err)
+			return
+		}
+
+		if len(results) != 1 || results[0] != 8 {
+			t.Errorf("Expected 8, got %+v", results)
+		}
+	}, 3)
+}
+
+func TestCompose(t *testing.T) {
+	Status("Calling Compose")
+	async.Compose(add1, times2)(func(err error, results ...interface{}) {
+		if err != nil {
+			t.Errorf("Unexpected error: %s", err)
+			return
+		}
+
+		if len(results) != 1 || results[0] != 7 {
+			t.Errorf("Expected 7, got %+v", results)
+		}
+	}, 3)
+}
+
+func TestSeqReused(t *testing.T) {
+	routine := async.Seq(add1, times2)
+
+	Status("Calling Parallel with a Seq")
+	async.ParallelGrouped([]async.Routine{
+		func(done async.Done, args ...interface{}) {
+			routine(done, 1)
+		},
+		func(done async.Done, args ...interface{}) {
+			routine(done, 2)
+		},
+	}, func(err error, results ...interface{}) {
+		if err != nil {
+			t.Errorf("Unexpected error: %s", err)
+			return
+		}
+
+		if results[0].([]interface{})[0] != 4 || results[1].([]interface{})[0] != 6 {
+			t.Errorf("Unexpected results: %+v", results)
+		}
+	})
+}
+
+func TestSeqError(t *testing.T) {
+	fail := func(done async.Done, args ...interface{}) {
+		done(fmt.Errorf("Test error"))
+	}
+
+	unreachable := func(done async.Done, args ...interface{}) {
+		t.Errorf("Seq did not stop when it errored.")
+		done(nil)
+	}
+
+	Status("Calling Seq")
+	async.Series([]async.Routine{
+		async.Seq(add1, fail, unreachable),
+	}, func(err error, results ...interface{}) {
+		if err == nil {
+			t.Errorf("Did not throw an error as expected")
+		}
+	})
+}
@@ -0,0 +1,59 @@
+package async
+
+import (
+	"reflect"
+)
+
+/*
+
+ConcatMap allows you to turn each value in a slice into a slice of its own,
+and join them all together. The values are processed in Parallel mode, the
+same as MapParallel.
+
+Each Routine will be called with the value and index of the current position
+in the slice, and passes a slice as the first argument to its Done function.
+The callbacks are given the values of every slice, in the same order as the
+slice that was passed in. Passing nil, or nothing at all, adds no values, and
+anything other than a slice is added as a single value.
+
+For example:
+  async.ConcatMap(dirs, func(done async.Done, args ...interface{}) {
+    files, err := filepath.Glob(filepath.Join(args[0].(string), "*.go"))
+    done(err, files)
+  }, func(err error, results ...interface{}) {
+    if err != nil {
+      fmt.Printf("Error: %s", err)
+      return
+    }
+
+    fmt.Printf("Files: %+v", results)
+  })
+
+*/
+func ConcatMap(data interface{}, routine Routine, callbacks ...Done) {
+	MapParallel(data, routine, func(err error, slices ...interface{}) {
+		var results []interface{}
+
+		if err == nil {
+			for i := 0; i < len(slices); i++ {
+				if slices[i] == nil {
+					continue
+				}
+
+				s := reflect.ValueOf(slices[i])
+				if s.Kind() != reflect.Slice && s.Kind() != reflect.Array {
+					results = append(results, slices[i])
+					continue
+				}
+
+				for j := 0; j < s.Len(); j++ {
+					results = append(results, s.Index(j).Interface())
+				}
+			}
+		}
+
+		for i := 0; i < len(callbacks); i++ {
+			callbacks[i](err, results...)
+		}
+	})
+}
@@ -0,0 +1,54 @@
+package async_test
+
+import (
+	"fmt"
+	"github.com/Southern/async"
+	"testing"
+)
+
+func TestConcatMap(t *testing.T) {
+	ints := []int{1, 2, 3}
+
+	expects := []interface{}{1, 2, 2, 3, 3, 3}
+
+	mapper := func(done async.Done, args ...interface{}) {
+		Status("Args: %+v", args)
+		repeated := make([]int, 0)
+		for i := 0; i < args[0].(int); i++ {
+			repeated = append(repeated, args[0].(int))
+		}
+		done(nil, repeated)
+	}
+
+	async.ConcatMap(ints, mapper, func(err error, results ...interface{}) {
+		Status("Results: %+v", results)
+		if err != nil {
+			t.Errorf("Unexpected error: %s", err)
+			return
+		}
+
+		if len(results) != len(expects) {
+			t.Errorf("Expected %d results, got %d", len(expects), len(results))
+			return
+		}
+
+		for i := 0; i < len(expects); i++ {
+			if results[i] != expects[i] {
+				t.Errorf("Did not concatenate correctly: %+v", results)
+				break
+			}
+		}
+	})
+}
+
+func TestConcatMapError(t *testing.T) {
+	mapper := func(done async.Done, args ...interface{}) {
+		done(fmt.Errorf("Test error"))
+	}
+
+	async.ConcatMap([]int{1, 2}, mapper, func(err error, results ...interface{}) {
+		if err == nil {
+			t.Errorf("Did not throw an error as expected")
+		}
+	})
+}
@@ -0,0 +1,214 @@
+package async
+
+import (
+	"errors"
+	"reflect"
+	"sync"
+)
+
+// errDetected is used to stop the routines once the answer is known. It is
+// never given to the callbacks.
+var errDetected = errors.New("detected")
+
+/*
+
+Detect allows you to find the first value in a slice that passes a test, in
+Waterfall mode.
+
+Each Routine will be called with the value and index of the current position
+in the slice. The value passes if the Done function is called with true as
+its first argument. As soon as a value passes, no more values are tested and
+the callbacks are given the value. If none of the values pass, the callbacks
+are given no results. When calling the Done function, an error will cause the
+search to immediately exit.
+
+For example:
+  async.Detect(files, func(done async.Done, args ...interface{}) {
+    _, err := os.Stat(args[0].(string))
+    done(nil, err == nil)
+  }, func(err error, results ...interface{}) {
+    if err != nil || len(results) == 0 {
+      fmt.Println("None of the files exist")
+      return
+    }
+
+    fmt.Printf("Found: %s", results[0])
+  })
+
+*/
+func Detect(data interface{}, routine Routine, callbacks ...Done) {
+	detect(data, true, 0, true, routine, found(callbacks))
+}
+
+/*
+
+DetectParallel allows you to find a value in a slice that passes a test, in
+Parallel mode.
+
+The callbacks are given the first value to pass, which is not always the
+earliest one in the slice. As soon as a value passes, no more routines are
+started. More documentation can be found on the Detect function.
+
+*/
+func DetectParallel(data interface{}, routine Routine, callbacks ...Done) {
+	detect(data, false, 0, true, routine, found(callbacks))
+}
+
+/*
+
+DetectParallelLimit allows you to find a value in a slice that passes a test,
+the same as DetectParallel, but with no more than limit values being tested at
+the same time.
+
+*/
+func DetectParallelLimit(data interface{}, limit int, routine Routine, callbacks ...Done) {
+	detect(data, false, limit, true, routine, found(callbacks))
+}
+
+/*
+
+Some allows you to check if any of the values in a slice pass a test, in
+Waterfall mode.
+
+The test works the same as Detect. The callbacks are given true as soon as a
+value passes, or false if none of them do.
+
+*/
+func Some(data interface{}, routine Routine, callbacks ...Done) {
+	detect(data, true, 0, true, routine, answer(true, callbacks))
+}
+
+/*
+
+SomeParallel allows you to check if any of the values in a slice pass a test,
+the same as Some, but in Parallel mode. As soon as a value passes, no more
+routines are started.
+
+*/
+func SomeParallel(data interface{}, routine Routine, callbacks ...Done) {
+	detect(data, false, 0, true, routine, answer(true, callbacks))
+}
+
+/*
+
+SomeParallelLimit allows you to check if any of the values in a slice pass a
+test, the same as SomeParallel, but with no more than limit values being
+tested at the same time.
+
+*/
+func SomeParallelLimit(data interface{}, limit int, routine Routine, callbacks ...Done) {
+	detect(data, false, limit, true, routine, answer(true, callbacks))
+}
+
+/*
+
+Every allows you to check if all of the values in a slice pass a test, in
+Waterfall mode.
+
+The test works the same as Detect. The callbacks are given false as soon as a
+value fails, or true if all of them pass.
+
+*/
+func Every(data interface{}, routine Routine, callbacks ...Done) {
+	detect(data, true, 0, false, routine, answer(false, callbacks))
+}
+
+/*
+
+EveryParallel allows you to check if all of the values in a slice pass a
+test, the same as Every, but in Parallel mode. As soon as a value fails, no
+more routines are started.
+
+*/
+func EveryParallel(data interface{}, routine Routine, callbacks ...Done) {
+	detect(data, false, 0, false, routine, answer(false, callbacks))
+}
+
+/*
+
+EveryParallelLimit allows you to check if all of the values in a slice pass a
+test, the same as EveryParallel, but with no more than limit values being
+tested at the same time.
+
+*/
+func EveryParallelLimit(data interface{}, limit int, routine Routine, callbacks ...Done) {
+	detect(data, false, limit, false, routine, answer(false, callbacks))
+}
+
+// found sends the detected value to the callbacks, if there was one.
+func found(callbacks []Done) func(error, bool, interface{}) {
+	return func(err error, ok bool, value interface{}) {
+		for i := 0; i < len(callbacks); i++ {
+			if ok {
+				callbacks[i](err, value)
+			} else {
+				callbacks[i](err)
+			}
+		}
+	}
+}
+
+// answer sends whether a value was detected to the callbacks. When want is
+// false, a detected value means that one of them failed the test, so the
+// answer is flipped.
+func answer(want bool, callbacks []Done) func(error, bool, interface{}) {
+	return func(err error, ok bool, value interface{}) {
+		for i := 0; i < len(callbacks); i++ {
+			if err != nil {
+				callbacks[i](err)
+			} else {
+				callbacks[i](err, ok == want)
+			}
+		}
+	}
+}
+
+// detect runs routine for the values in data until one of them gives the
+// result that is wanted. When serial is true the values are tested in
+// Waterfall mode, otherwise they are tested in Parallel mode with the limit
+// provided.
+func detect(data interface{}, serial bool, limit int, want bool, routine Routine, final func(error, bool, interface{})) {
+	var (
+		routines []Routine
+
+		once  sync.Once
+		value interface{}
+	)
+
+	d := reflect.ValueOf(data)
+
+	for i := 0; i < d.Len(); i++ {
+		v := d.Index(i).Interface()
+		routines = append(routines, func(id int) Routine {
+			return func(done Done, args ...interface{}) {
+				routine(func(err error, args ...interface{}) {
+					if err == nil && (len(args) > 0 && args[0] == true) == want {
+						once.Do(func() {
+							value = v
+						})
+
+						// Stop the rest of the routines, since we already
+						// have our answer.
+						err = errDetected
+					}
+					done(err)
+				}, v, id)
+			}
+		}(i))
+	}
+
+	finish := func(err error, args ...interface{}) {
+		if err == errDetected {
+			final(nil, true, value)
+			return
+		}
+		final(err, false, nil)
+	}
+
+	if serial {
+		Series(routines, finish)
+		return
+	}
+
+	ParallelLimit(routines, limit, finish)
+}
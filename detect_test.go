@@ -0,0 +1,128 @@
+package async_test
+
+import (
+	"fmt"
+	"github.com/Southern/async"
+	"sync"
+	"testing"
+)
+
+func TestDetect(t *testing.T) {
+	ints := []int{1, 2, 3, 4, 5}
+	tested := 0
+
+	Status("Calling Detect")
+	async.Detect(ints, func(done async.Done, args ...interface{}) {
+		Status("Args: %+v", args)
+		tested++
+		done(nil, args[0].(int) > 2)
+	}, func(err error, results ...interface{}) {
+		if err != nil {
+			t.Errorf("Unexpected error: %s", err)
+			return
+		}
+
+		if len(results) != 1 || results[0] != 3 {
+			t.Errorf("Unexpected results: %+v", results)
+		}
+	})
+
+	if tested != 3 {
+		t.Errorf("Detect did not stop once a value passed, tested %d", tested)
+	}
+}
+
+func TestDetectNotFound(t *testing.T) {
+	Status("Calling DetectParallel")
+	async.DetectParallel([]int{1, 2, 3}, func(done async.Done, args ...interface{}) {
+		done(nil, false)
+	}, func(err error, results ...interface{}) {
+		if err != nil || len(results) != 0 {
+			t.Errorf("Expected no results, got %+v", results)
+		}
+	})
+}
+
+func TestDetectParallelLimit(t *testing.T) {
+	var (
+		mutex  sync.Mutex
+		tested int
+	)
+
+	Status("Calling DetectParallelLimit")
+	async.DetectParallelLimit([]int{1, 2, 3, 4, 5, 6}, 1, func(done async.Done, args ...interface{}) {
+		mutex.Lock()
+		tested++
+		mutex.Unlock()
+		done(nil, args[0] == 2)
+	}, func(err error, results ...interface{}) {
+		if err != nil || len(results) != 1 || results[0] != 2 {
+			t.Errorf("Unexpected results: %+v", results)
+		}
+	})
+
+	if tested != 2 {
+		t.Errorf("Routines were started after a value passed, tested %d", tested)
+	}
+}
+
+func TestSome(t *testing.T) {
+	even := func(done async.Done, args ...interface{}) {
+		done(nil, args[0].(int)%2 == 0)
+	}
+
+	expect := func(expected bool) async.Done {
+		return func(err error, results ...interface{}) {
+			if err != nil {
+				t.Errorf("Unexpected error: %s", err)
+				return
+			}
+
+			if results[0] != expected {
+				t.Errorf("Expected %t, got %+v", expected, results[0])
+			}
+		}
+	}
+
+	Status("Calling Some")
+	async.Some([]int{1, 3, 4}, even, expect(true))
+	async.Some([]int{1, 3, 5}, even, expect(false))
+	async.SomeParallel([]int{1, 3, 4}, even, expect(true))
+	async.SomeParallelLimit([]int{1, 3, 5}, 2, even, expect(false))
+}
+
+func TestEvery(t *testing.T) {
+	even := func(done async.Done, args ...interface{}) {
+		done(nil, args[0].(int)%2 == 0)
+	}
+
+	expect := func(expected bool) async.Done {
+		return func(err error, results ...interface{}) {
+			if err != nil {
+				t.Errorf("Unexpected error: %s", err)
+				return
+			}
+
+			if results[0] != expected {
+				t.Errorf("Expected %t, got %+v", expected, results[0])
+			}
+		}
+	}
+
+	Status("Calling Every")
+	async.Every([]int{2, 4, 6}, even, expect(true))
+	async.Every([]int{2, 3, 6}, even, expect(false))
+	async.EveryParallel([]int{2, 4, 6}, even, expect(true))
+	async.EveryParallelLimit([]int{2, 3, 6}, 2, even, expect(false))
+}
+
+func TestSomeError(t *testing.T) {
+	Status("Calling SomeParallel")
+	async.SomeParallel([]int{1, 2}, func(done async.Done, args ...interface{}) {
+		done(fmt.Errorf("Test error"))
+	}, func(err error, results ...interface{}) {
+		if err == nil {
+			t.Errorf("Did not throw an error as expected")
+		}
+	})
+}
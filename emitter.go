@@ -0,0 +1,223 @@
+package async
+
+import (
+	"sync"
+)
+
+// listener is a single function for an Emitter event, along with the number
+// of times that it has left to be called.
+type listener[T any] struct {
+	fn    func(T) error
+	times int
+}
+
+/*
+
+Emitter is a typed alternative to Events. Every listener takes a single value
+of type T and returns an error, so passing the wrong kind of value to Emit is
+caught at compile time instead of panicking inside of reflect.
+
+All you need to do to create an emitter is:
+  emitter := async.NewEmitter[string]()
+
+All emitter commands other than Emit can be chained together. For example:
+  emitter.On("myevent", func(msg string) error {
+    fmt.Printf("Called myevent with message: %s\n", msg)
+    return nil
+  }).Once("myevent2", func(msg string) error {
+    return fmt.Errorf("Some error message")
+  })
+
+  err := emitter.Emit("myevent", "Testing")
+
+Listeners are called one after another, in the order that they were added.
+
+*/
+type Emitter[T any] struct {
+	mutex  sync.Mutex
+	events map[string][]*listener[T]
+}
+
+// NewEmitter will create a new Emitter instance
+func NewEmitter[T any]() *Emitter[T] {
+	return &Emitter[T]{
+		events: make(map[string][]*listener[T]),
+	}
+}
+
+/*
+
+Bridge connects an existing Events list to the emitter. Whenever the named
+event is emitted on events with a value of type T, it is emitted on the
+emitter as well. Any error from the emitter's listeners is emitted as an
+error event on events, the same as any other Events function.
+
+For example:
+  events := make(async.Events)
+  emitter := async.NewEmitter[string]().Bridge(events, "message")
+
+  events.Emit("message", "Testing") // Also calls the emitter's listeners
+
+Returns the emitter for chaining commands.
+
+*/
+func (e *Emitter[T]) Bridge(events Events, name string) *Emitter[T] {
+	events.On(name, func(value T) error {
+		return e.Emit(name, value)
+	})
+
+	return e
+}
+
+/*
+
+Emit an event with the value provided. Each listener is called one after
+another, and the first error that is returned stops the rest of the
+listeners from being called. Listeners that were never called keep all of
+their remaining calls. Any panic inside of a listener is returned as a
+*PanicError.
+
+*/
+func (e *Emitter[T]) Emit(name string, value T) error {
+	e.mutex.Lock()
+	listeners := e.events[name]
+	e.mutex.Unlock()
+
+	for i := 0; i < len(listeners); i++ {
+		if !e.use(name, listeners[i]) {
+			continue
+		}
+
+		fn := listeners[i].fn
+		if err := protect(func() error { return fn(value) }); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// use takes one call away from a listener of the named event, removing the
+// listener once it has no calls left. It returns false if the listener had
+// already been used up, such as by another call to Emit.
+func (e *Emitter[T]) use(name string, l *listener[T]) bool {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	if l.times == 0 {
+		return false
+	}
+
+	// Decrease frequency, and only keep the listener while it still has
+	// calls left.
+	if l.times > 0 {
+		l.times--
+	}
+
+	if l.times != 0 {
+		return true
+	}
+
+	listeners := e.events[name]
+	remaining := make([]*listener[T], 0, len(listeners))
+
+	for i := 0; i < len(listeners); i++ {
+		if listeners[i] != l {
+			remaining = append(remaining, listeners[i])
+		}
+	}
+
+	if len(remaining) == 0 {
+		delete(e.events, name)
+	} else {
+		e.events[name] = remaining
+	}
+
+	return true
+}
+
+/*
+
+Length gets the number of listeners for the named event.
+
+*/
+func (e *Emitter[T]) Length(name string) int {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	return len(e.events[name])
+}
+
+/*
+
+Off removes all of the listeners for the named events. If no names are
+provided, all of the listeners for every event are removed.
+
+Returns the emitter for chaining commands.
+
+*/
+func (e *Emitter[T]) Off(names ...string) *Emitter[T] {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	if names != nil {
+		for i := 0; i < len(names); i++ {
+			delete(e.events, names[i])
+		}
+		return e
+	}
+
+	e.events = make(map[string][]*listener[T])
+	return e
+}
+
+/*
+
+On adds listeners to be called forever.
+
+This is equal to calling Times with -1 as the number of times to run the
+listeners.
+
+Returns the emitter for chaining commands.
+
+*/
+func (e *Emitter[T]) On(name string, listeners ...func(T) error) *Emitter[T] {
+	return e.Times(name, -1, listeners...)
+}
+
+/*
+
+Once adds listeners to be called only once.
+
+This is equal to calling Times with 1 as the number of times to run the
+listeners.
+
+Returns the emitter for chaining commands.
+
+*/
+func (e *Emitter[T]) Once(name string, listeners ...func(T) error) *Emitter[T] {
+	return e.Times(name, 1, listeners...)
+}
+
+/*
+
+Times adds listeners to be called a number of times. If the number of times
+for the listeners to be called is -1, they will be called until they are
+removed with Off.
+
+Returns the emitter for chaining commands.
+
+*/
+func (e *Emitter[T]) Times(name string, times int, listeners ...func(T) error) *Emitter[T] {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	for i := 0; i < len(listeners); i++ {
+		e.events[name] = append(e.events[name], &listener[T]{
+			fn:    listeners[i],
+			times: times,
+		})
+	}
+
+	return e
+}
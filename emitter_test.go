@@ -0,0 +1,165 @@
+package async_test
+
+import (
+	"fmt"
+	"github.com/Southern/async"
+	"testing"
+)
+
+func TestEmitterOn(t *testing.T) {
+	var messages []string
+
+	Status("Creating emitter")
+	emitter := async.NewEmitter[string]()
+
+	emitter.On("test", func(msg string) error {
+		Status("Got message: %s", msg)
+		messages = append(messages, msg)
+		return nil
+	})
+
+	Status("Emitting event")
+	if err := emitter.Emit("test", "first"); err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+	emitter.Emit("test", "second")
+
+	if len(messages) != 2 || messages[1] != "second" {
+		t.Errorf("Unexpected messages: %+v", messages)
+	}
+
+	if emitter.Length("test") != 1 {
+		t.Errorf("Listener was removed")
+	}
+}
+
+func TestEmitterOnceAndTimes(t *testing.T) {
+	counter := 0
+	increase := func(n int) error {
+		counter += n
+		return nil
+	}
+
+	Status("Creating emitter")
+	emitter := async.NewEmitter[int]().Once("test", increase).Times("test", 2, increase)
+
+	if emitter.Length("test") != 2 {
+		t.Errorf("Not all listeners were added")
+		return
+	}
+
+	emitter.Emit("test", 1)
+	if emitter.Length("test") != 1 {
+		t.Errorf("Once listener was not removed")
+		return
+	}
+
+	emitter.Emit("test", 1)
+	emitter.Emit("test", 1)
+
+	if counter != 3 || emitter.Length("test") != 0 {
+		t.Errorf("Unexpected counter %d with %d listeners", counter, emitter.Length("test"))
+	}
+}
+
+func TestEmitterOff(t *testing.T) {
+	noop := func(msg string) error { return nil }
+
+	emitter := async.NewEmitter[string]().On("test", noop).On("test2", noop)
+
+	emitter.Off("test")
+	if emitter.Length("test") != 0 || emitter.Length("test2") != 1 {
+		t.Errorf("Event was not properly removed")
+		return
+	}
+
+	emitter.Off()
+	if emitter.Length("test2") != 0 {
+		t.Errorf("Emitter wasn't cleared")
+	}
+}
+
+func TestEmitterError(t *testing.T) {
+	called := false
+
+	emitter := async.NewEmitter[string]().On("test", func(msg string) error {
+		return fmt.Errorf("Testing")
+	}, func(msg string) error {
+		called = true
+		return nil
+	})
+
+	if err := emitter.Emit("test", "blah"); err == nil {
+		t.Errorf("Expected an error")
+		return
+	}
+
+	if called {
+		t.Errorf("Listeners after the error were called")
+	}
+}
+
+func TestEmitterErrorKeepsOnce(t *testing.T) {
+	var (
+		fail   = true
+		called = 0
+	)
+
+	emitter := async.NewEmitter[string]().On("test", func(msg string) error {
+		if fail {
+			return fmt.Errorf("Testing")
+		}
+		return nil
+	}).Once("test", func(msg string) error {
+		called++
+		return nil
+	})
+
+	if err := emitter.Emit("test", "blah"); err == nil {
+		t.Errorf("Expected an error")
+		return
+	}
+
+	if emitter.Length("test") != 2 {
+		t.Errorf("Once listener was removed without being called")
+		return
+	}
+
+	fail = false
+	emitter.Emit("test", "blah")
+	emitter.Emit("test", "blah")
+
+	if called != 1 || emitter.Length("test") != 1 {
+		t.Errorf("Unexpected calls %d with %d listeners", called, emitter.Length("test"))
+	}
+}
+
+func TestEmitterBridge(t *testing.T) {
+	var (
+		message string
+		_err    error
+	)
+
+	Status("Creating events and emitter")
+	events := make(async.Events)
+	events.On("error", func(err error) {
+		_err = err
+	})
+
+	async.NewEmitter[string]().Bridge(events, "test").On("test", func(msg string) error {
+		message = msg
+		return fmt.Errorf("Testing")
+	})
+
+	Status("Emitting event through events")
+	events.Emit("test", "Bridged")
+
+	if message != "Bridged" {
+		t.Errorf("Event was not bridged to the emitter")
+	}
+
+	if _err == nil {
+		t.Errorf("Expected an error event")
+	}
+}
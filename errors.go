@@ -0,0 +1,110 @@
+package async
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var (
+	// ErrDoneCalledTwice is returned when a Routine calls its Done function
+	// more than once.
+	ErrDoneCalledTwice = errors.New("done was called more than once")
+
+	// ErrTimeout is returned when a Routine that was wrapped with Timeout
+	// doesn't call its Done function in time.
+	ErrTimeout = errors.New("routine timed out")
+
+	// ErrMissingDependency is returned by Auto when a Task requires a task
+	// that doesn't exist.
+	ErrMissingDependency = errors.New("missing dependency")
+
+	// ErrDependencyCycle is returned by Auto when tasks depend on each other
+	// in a cycle, so that none of them would ever be able to run.
+	ErrDependencyCycle = errors.New("dependency cycle")
+
+	// ErrKilled is given to the callbacks of any task that is still waiting
+	// in a Queue when it is killed, or that is pushed after it was killed.
+	ErrKilled = errors.New("queue was killed")
+)
+
+/*
+
+RoutineError is used to tag an error returned by a Routine with the position
+of that Routine in the list that it was ran from.
+
+The original error can be retrieved with errors.Unwrap, errors.Is or
+errors.As.
+
+*/
+type RoutineError struct {
+	Index int
+	Err   error
+}
+
+// Error returns the original error message prefixed by the Routine's position
+func (e *RoutineError) Error() string {
+	return fmt.Sprintf("routine %d: %s", e.Index, e.Err)
+}
+
+// Unwrap returns the original error that the Routine returned
+func (e *RoutineError) Unwrap() error {
+	return e.Err
+}
+
+/*
+
+Errors is used to combine multiple errors into a single error. It works the
+same as an error created with errors.Join, so errors.Is and errors.As will
+check every error that it contains.
+
+For example:
+  if errors.Is(err, sql.ErrNoRows) {
+    // At least one of the routines couldn't find its row.
+  }
+
+*/
+type Errors []error
+
+// Error returns the messages of all of the errors, one per line
+func (e Errors) Error() string {
+	messages := make([]string, 0, len(e))
+
+	for i := 0; i < len(e); i++ {
+		messages = append(messages, e[i].Error())
+	}
+
+	return strings.Join(messages, "\n")
+}
+
+// Unwrap returns all of the errors that were combined
+func (e Errors) Unwrap() []error {
+	return e
+}
+
+/*
+
+PanicError is used when a Routine panics. Instead of crashing the program, the
+panic is recovered and sent to the Routine's Done function as a PanicError.
+
+Value holds the value that was passed to panic, and Stack holds the stack
+trace of the goroutine at the time of the panic.
+
+*/
+type PanicError struct {
+	Value interface{}
+	Stack []byte
+}
+
+// Error returns the value that was passed to panic
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("routine panicked: %v", e.Value)
+}
+
+// Unwrap returns the value that was passed to panic, if it was an error
+func (e *PanicError) Unwrap() error {
+	if err, ok := e.Value.(error); ok {
+		return err
+	}
+	return nil
+}
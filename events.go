@@ -62,6 +62,9 @@ many arguments for the first function. If you were to try .Emit("myevent"),
 you would get an error that there are not enough arguments because of the
 second function expecting an argument.
 
+Any panic inside of a function, including the ones from reflect above, is
+recovered and emitted as an error event holding a *PanicError.
+
 */
 type Events map[string]Event
 
@@ -168,8 +171,9 @@ func (e Events) Emit(name string, args ...interface{}) Events {
 	// Run all of the events in Series
 	Series(routines, func(err error, args ...interface{}) {
 		// Only emit the error event if an error was detected. Nothing else needs
-		// to be done here.
-		if err != nil {
+		// to be done here. Errors from the error event itself are not emitted
+		// again, otherwise a broken error callback would loop forever.
+		if err != nil && name != "error" {
 			e.Emit("error", err)
 		}
 	})
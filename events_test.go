@@ -340,3 +340,29 @@ func TestEventInheritanceErrorReturn(t *testing.T) {
 		return
 	}
 }
+
+func TestEventPanic(t *testing.T) {
+	var _err error
+
+	Status("Clear list")
+	events.Clear()
+
+	Status("Add event")
+	events.On("test",
+		func(msg string) {
+			Status("Got message: %s", msg)
+		},
+	).On("error",
+		func(err error) {
+			Status("Got error: %s", err)
+			_err = err
+		},
+	)
+
+	Status("Emitting event with the wrong arguments")
+	events.Emit("test", 1, 2)
+
+	if _, ok := _err.(*async.PanicError); !ok {
+		t.Errorf("Expected a panic error, got %+v", _err)
+	}
+}
@@ -1,6 +1,7 @@
 package async
 
 import (
+	"context"
 	"reflect"
 )
 
@@ -150,26 +151,44 @@ For example, take a look at one of the tests for this function:
 Each Routine function will be passed the current value and its index the
 slice for its arguments.
 
-The output of filtering in Parallel mode cannot be guaranteed to stay in the
-same order, due to the fact that it may take longer to process some things
-in your filter routine. If you need the data to stay in the order it is in,
-use Filter instead to ensure it stays in order.
+Even though the values are processed in Parallel mode, the values that are
+kept are returned in the same order that they were in the slice, no matter
+which order the routines finished in.
 
 */
 func FilterParallel(data interface{}, routine Routine, callbacks ...Done) {
+	FilterParallelLimit(data, 0, routine, callbacks...)
+}
+
+/*
+
+FilterParallelLimit allows you to filter out information from a slice in
+Parallel mode, the same as FilterParallel, but with no more than limit values
+being processed at the same time. If limit is less than 1, all of the values
+are processed at once.
+
+The values that are kept are returned in the same order as the slice, the
+same as FilterParallel. More documentation on how the limit is handled can be
+found on the RunParallelLimit function.
+
+*/
+func FilterParallelLimit(data interface{}, limit int, routine Routine, callbacks ...Done) {
 	var routines []Routine
 
 	d := reflect.ValueOf(data)
 
+	// Keep track of which values passed the filter by their index, so that
+	// they can be returned in the same order as the slice.
+	keep := make([]bool, d.Len())
+
 	for i := 0; i < d.Len(); i++ {
 		v := d.Index(i).Interface()
 		routines = append(routines, func(id int) Routine {
 			return func(done Done, args ...interface{}) {
 				done = func(original Done) Done {
 					return func(err error, args ...interface{}) {
-						if args[0] != false {
-							original(err, v)
-							return
+						if err == nil && args[0] != false {
+							keep[id] = true
 						}
 						original(err)
 					}
@@ -180,5 +199,106 @@ func FilterParallel(data interface{}, routine Routine, callbacks ...Done) {
 		}(i))
 	}
 
-	Parallel(routines, callbacks...)
+	ParallelLimit(routines, limit, func(err error, args ...interface{}) {
+		var results []interface{}
+
+		if err == nil {
+			for i := 0; i < len(keep); i++ {
+				if keep[i] {
+					results = append(results, d.Index(i).Interface())
+				}
+			}
+		}
+
+		for i := 0; i < len(callbacks); i++ {
+			callbacks[i](err, results...)
+		}
+	})
+}
+
+/*
+
+FilterOf allows you to filter out information from a slice, the same as
+Filter, but with types checked at compile time instead of using reflection.
+
+Each value is passed into fn with its index, one after another. Only the
+values that fn returns true for are kept. The first error stops the filtering
+and is returned. If ctx is cancelled, the filtering stops before the next
+value and the error from ctx is returned.
+
+For example:
+  even, err := async.FilterOf(ctx, []int{1, 2, 3, 4},
+    func(ctx context.Context, v int, i int) (bool, error) {
+      return v%2 == 0, nil
+    })
+
+*/
+func FilterOf[T any](ctx context.Context, data []T, fn func(context.Context, T, int) (bool, error)) ([]T, error) {
+	results := make([]T, 0)
+
+	for i := 0; i < len(data); i++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		var keep bool
+		err := protect(func() (err error) {
+			keep, err = fn(ctx, data[i], i)
+			return err
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		if keep {
+			results = append(results, data[i])
+		}
+	}
+
+	return results, nil
+}
+
+/*
+
+FilterOfParallel allows you to filter out information from a slice in
+Parallel mode, the same as FilterParallel, but with types checked at compile
+time instead of using reflection.
+
+The values that are kept are returned in the same order as the slice. The
+context given to fn is cancelled as soon as one of the values returns an
+error, and that first error is returned once all of the running calls have
+finished.
+
+*/
+func FilterOfParallel[T any](ctx context.Context, data []T, fn func(context.Context, T, int) (bool, error)) ([]T, error) {
+	return FilterOfParallelLimit(ctx, data, 0, fn)
+}
+
+/*
+
+FilterOfParallelLimit allows you to filter out information from a slice in
+Parallel mode, the same as FilterOfParallel, but with no more than limit
+values being processed at the same time. If limit is less than 1, all of the
+values are processed at once.
+
+*/
+func FilterOfParallelLimit[T any](ctx context.Context, data []T, limit int, fn func(context.Context, T, int) (bool, error)) ([]T, error) {
+	keep := make([]bool, len(data))
+
+	err := eachParallel(ctx, len(data), limit, func(ctx context.Context, i int) (err error) {
+		keep[i], err = fn(ctx, data[i], i)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]T, 0)
+	for i := 0; i < len(data); i++ {
+		if keep[i] {
+			results = append(results, data[i])
+		}
+	}
+
+	return results, nil
 }
@@ -1,8 +1,12 @@
 package async_test
 
 import (
+	"context"
+	"fmt"
 	"github.com/Southern/async"
+	"sync"
 	"testing"
+	"time"
 )
 
 func TestFilterString(t *testing.T) {
@@ -244,3 +248,173 @@ func TestFilterIntParallel(t *testing.T) {
 
 	async.FilterParallel(bools, mapper, final)
 }
+
+func TestFilterIntParallelLimit(t *testing.T) {
+	var (
+		mutex   sync.Mutex
+		running int
+		most    int
+	)
+
+	ints := []int{
+		1,
+		2,
+		3,
+		4,
+		5,
+	}
+
+	expects := []int{
+		1,
+		2,
+		4,
+		5,
+	}
+
+	mapper := func(done async.Done, args ...interface{}) {
+		Status("Hit int")
+		Status("Args: %+v\n", args)
+
+		mutex.Lock()
+		running++
+		if running > most {
+			most = running
+		}
+		mutex.Unlock()
+
+		time.Sleep(10 * time.Millisecond)
+
+		mutex.Lock()
+		running--
+		mutex.Unlock()
+
+		if args[0] == 3 {
+			done(nil, false)
+			return
+		}
+		done(nil, true)
+	}
+
+	final := func(err error, results ...interface{}) {
+		Status("Hit int end")
+		Status("Results: %+v\n", results)
+		if len(results) != len(expects) {
+			t.Errorf("Expected %d results, got %d", len(expects), len(results))
+			return
+		}
+		for i := 0; i < len(results); i++ {
+			if results[i] != expects[i] {
+				t.Errorf("Did not filter correctly.")
+				break
+			}
+		}
+	}
+
+	async.FilterParallelLimit(ints, 2, mapper, final)
+
+	if most > 2 {
+		t.Errorf("Expected at most 2 routines running at once, got %d", most)
+	}
+}
+
+func TestFilterParallelOrder(t *testing.T) {
+	ints := []int{1, 2, 3, 4, 5, 6}
+
+	expects := []int{2, 4, 6}
+
+	mapper := func(done async.Done, args ...interface{}) {
+		Status("Hit int")
+		Status("Args: %+v\n", args)
+
+		// Make the first values finish last.
+		time.Sleep(time.Duration(len(ints)-args[1].(int)) * 10 * time.Millisecond)
+		done(nil, args[0].(int)%2 == 0)
+	}
+
+	final := func(err error, results ...interface{}) {
+		Status("Hit int end")
+		Status("Results: %+v\n", results)
+		if len(results) != len(expects) {
+			t.Errorf("Expected %d results, got %d", len(expects), len(results))
+			return
+		}
+		for i := 0; i < len(results); i++ {
+			if results[i] != expects[i] {
+				t.Errorf("Results were not kept in order: %+v", results)
+				break
+			}
+		}
+	}
+
+	async.FilterParallel(ints, mapper, final)
+}
+
+func TestFilterOf(t *testing.T) {
+	str := []string{"test1", "test2", "test3", "test4", "test5"}
+
+	expects := []string{"test1", "test2", "test4", "test5"}
+
+	results, err := async.FilterOf(context.Background(), str,
+		func(ctx context.Context, v string, i int) (bool, error) {
+			Status("Hit string %s at %d", v, i)
+			return v != "test3", nil
+		})
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+
+	if len(results) != len(expects) {
+		t.Errorf("Expected %d results, got %d", len(expects), len(results))
+		return
+	}
+
+	for i := 0; i < len(expects); i++ {
+		if results[i] != expects[i] {
+			t.Errorf("Did not filter correctly: %+v", results)
+			break
+		}
+	}
+}
+
+func TestFilterOfError(t *testing.T) {
+	_, err := async.FilterOf(context.Background(), []int{1, 2, 3},
+		func(ctx context.Context, v int, i int) (bool, error) {
+			if v == 2 {
+				return false, fmt.Errorf("Test error")
+			}
+			return true, nil
+		})
+	if err == nil {
+		t.Errorf("Did not throw an error as expected")
+	}
+}
+
+func TestFilterOfParallel(t *testing.T) {
+	ints := []int{1, 2, 3, 4, 5, 6}
+
+	expects := []int{2, 4, 6}
+
+	results, err := async.FilterOfParallel(context.Background(), ints,
+		func(ctx context.Context, v int, i int) (bool, error) {
+			// Make the first values finish last.
+			time.Sleep(time.Duration(len(ints)-i) * 10 * time.Millisecond)
+			return v%2 == 0, nil
+		})
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+
+	if len(results) != len(expects) {
+		t.Errorf("Expected %d results, got %d", len(expects), len(results))
+		return
+	}
+
+	for i := 0; i < len(expects); i++ {
+		if results[i] != expects[i] {
+			t.Errorf("Results were not kept in order: %+v", results)
+			break
+		}
+	}
+}
@@ -0,0 +1,3 @@
+module github.com/Southern/async
+
+go 1.20
@@ -0,0 +1,68 @@
+package async
+
+import (
+	"fmt"
+	"reflect"
+)
+
+/*
+
+GroupBy allows you to group the data in a slice by a key that is worked out by
+a Routine. The keys are worked out in Parallel mode, the same as MapParallel.
+
+Each Routine will be called with the value and index of the current position
+in the slice, and passes the key for that value as the first argument to its
+Done function. Keys must be able to be used as a map key, otherwise the
+callbacks are triggered with an error.
+
+The callbacks are given a single map[interface{}][]interface{}, holding the
+values for each key in the same order that they were in the slice.
+
+For example:
+  async.GroupBy(files, func(done async.Done, args ...interface{}) {
+    info, err := os.Stat(args[0].(string))
+    if err != nil {
+      done(err)
+      return
+    }
+    done(nil, info.IsDir())
+  }, func(err error, results ...interface{}) {
+    if err != nil {
+      fmt.Printf("Error: %s", err)
+      return
+    }
+
+    groups := results[0].(map[interface{}][]interface{})
+    fmt.Printf("Directories: %+v", groups[true])
+  })
+
+*/
+func GroupBy(data interface{}, routine Routine, callbacks ...Done) {
+	MapParallel(data, routine, func(err error, keys ...interface{}) {
+		// A key that can't be used in a map, such as a slice, would panic
+		// when it is grouped.
+		for i := 0; err == nil && i < len(keys); i++ {
+			if keys[i] != nil && !reflect.ValueOf(keys[i]).Comparable() {
+				err = fmt.Errorf("cannot group by key %T", keys[i])
+			}
+		}
+
+		if err != nil {
+			for i := 0; i < len(callbacks); i++ {
+				callbacks[i](err)
+			}
+			return
+		}
+
+		d := reflect.ValueOf(data)
+		groups := make(map[interface{}][]interface{})
+
+		for i := 0; i < len(keys); i++ {
+			groups[keys[i]] = append(groups[keys[i]], d.Index(i).Interface())
+		}
+
+		for i := 0; i < len(callbacks); i++ {
+			callbacks[i](nil, groups)
+		}
+	})
+}
@@ -0,0 +1,62 @@
+package async_test
+
+import (
+	"fmt"
+	"github.com/Southern/async"
+	"testing"
+)
+
+func TestGroupBy(t *testing.T) {
+	ints := []int{1, 2, 3, 4, 5, 6}
+
+	keyer := func(done async.Done, args ...interface{}) {
+		Status("Args: %+v", args)
+		done(nil, args[0].(int)%2 == 0)
+	}
+
+	async.GroupBy(ints, keyer, func(err error, results ...interface{}) {
+		Status("Results: %+v", results)
+		if err != nil {
+			t.Errorf("Unexpected error: %s", err)
+			return
+		}
+
+		groups := results[0].(map[interface{}][]interface{})
+		even, odd := groups[true], groups[false]
+
+		if len(even) != 3 || even[0] != 2 || even[2] != 6 {
+			t.Errorf("Did not group correctly: %+v", groups)
+		}
+
+		if len(odd) != 3 || odd[0] != 1 || odd[2] != 5 {
+			t.Errorf("Did not group correctly: %+v", groups)
+		}
+	})
+}
+
+func TestGroupByError(t *testing.T) {
+	keyer := func(done async.Done, args ...interface{}) {
+		done(fmt.Errorf("Test error"))
+	}
+
+	async.GroupBy([]int{1, 2}, keyer, func(err error, results ...interface{}) {
+		if err == nil {
+			t.Errorf("Did not throw an error as expected")
+		}
+	})
+}
+
+func TestGroupByUnhashableKey(t *testing.T) {
+	keyer := func(done async.Done, args ...interface{}) {
+		done(nil, []int{args[0].(int)})
+	}
+
+	async.GroupBy([]int{1, 2}, keyer, func(err error, results ...interface{}) {
+		if err == nil {
+			t.Errorf("Did not throw an error as expected")
+			return
+		}
+
+		Status("Got error: %s", err)
+	})
+}
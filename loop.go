@@ -0,0 +1,113 @@
+package async
+
+import (
+	"context"
+)
+
+/*
+
+Whilst will repeatedly run routine for as long as test passes.
+
+The test is a Routine as well, and it passes by calling its Done function with
+true as its first argument. It is given the arguments from the last run of
+routine. The test is ran before every run of routine, so routine may not be
+ran at all.
+
+If either of them returns an error, the loop will immediately exit and trigger
+the callbacks with the error. Otherwise, the callbacks are given the arguments
+from the last run of routine.
+
+For example:
+  count := 0
+
+  async.Whilst(func(done async.Done, args ...interface{}) {
+    done(nil, count < 5)
+  }, func(done async.Done, args ...interface{}) {
+    count++
+    done(nil, count)
+  }, func(err error, results ...interface{}) {
+    fmt.Printf("Count: %d", results[0])
+  })
+
+*/
+func Whilst(test Routine, routine Routine, callbacks ...Done) {
+	loop(test, routine, false, true, callbacks...)
+}
+
+/*
+
+DoWhilst will repeatedly run routine for as long as test passes, the same as
+Whilst, except that routine is ran once before test is checked.
+
+*/
+func DoWhilst(routine Routine, test Routine, callbacks ...Done) {
+	loop(test, routine, true, true, callbacks...)
+}
+
+/*
+
+Until will repeatedly run routine until test passes. This is the opposite of
+Whilst, and test is checked before every run of routine in the same way.
+
+*/
+func Until(test Routine, routine Routine, callbacks ...Done) {
+	loop(test, routine, false, false, callbacks...)
+}
+
+/*
+
+Forever will run routine over and over again until it returns an error. The
+callbacks are then triggered with that error.
+
+*/
+func Forever(routine Routine, callbacks ...Done) {
+	var (
+		err  error
+		late = make(chan error, 1)
+	)
+
+	for err == nil {
+		_, err = await(context.Background(), routine, late)
+	}
+
+	for i := 0; i < len(callbacks); i++ {
+		callbacks[i](err)
+	}
+}
+
+// loop runs routine for as long as the result of test matches pass. When
+// first is true, routine is ran once before test is checked.
+func loop(test Routine, routine Routine, first bool, pass bool, callbacks ...Done) {
+	var (
+		err     error
+		args    []interface{}
+		results []interface{}
+
+		late = make(chan error, 1)
+	)
+
+	for {
+		if !first {
+			results, err = await(context.Background(), test, late, args...)
+			if err != nil {
+				args = nil
+				break
+			}
+
+			if (len(results) > 0 && results[0] == true) != pass {
+				break
+			}
+		}
+		first = false
+
+		args, err = await(context.Background(), routine, late)
+		if err != nil {
+			args = nil
+			break
+		}
+	}
+
+	for i := 0; i < len(callbacks); i++ {
+		callbacks[i](err, args...)
+	}
+}
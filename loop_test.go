@@ -0,0 +1,129 @@
+package async_test
+
+import (
+	"fmt"
+	"github.com/Southern/async"
+	"testing"
+)
+
+func TestWhilst(t *testing.T) {
+	count := 0
+
+	Status("Calling Whilst")
+	async.Whilst(func(done async.Done, args ...interface{}) {
+		Status("Testing with arguments: %+v", args)
+		done(nil, count < 5)
+	}, func(done async.Done, args ...interface{}) {
+		count++
+		done(nil, count)
+	}, func(err error, results ...interface{}) {
+		if err != nil {
+			t.Errorf("Unexpected error: %s", err)
+			return
+		}
+
+		if count != 5 || results[0] != 5 {
+			t.Errorf("Expected 5 runs, got %d", count)
+		}
+	})
+}
+
+func TestWhilstNeverRuns(t *testing.T) {
+	Status("Calling Whilst")
+	async.Whilst(func(done async.Done, args ...interface{}) {
+		done(nil, false)
+	}, func(done async.Done, args ...interface{}) {
+		t.Errorf("Routine was ran when the test failed")
+		done(nil)
+	}, func(err error, results ...interface{}) {
+		if err != nil {
+			t.Errorf("Unexpected error: %s", err)
+		}
+	})
+}
+
+func TestDoWhilst(t *testing.T) {
+	count := 0
+
+	Status("Calling DoWhilst")
+	async.DoWhilst(func(done async.Done, args ...interface{}) {
+		count++
+		done(nil, count)
+	}, func(done async.Done, args ...interface{}) {
+		Status("Testing with arguments: %+v", args)
+		done(nil, false)
+	}, func(err error, results ...interface{}) {
+		if err != nil {
+			t.Errorf("Unexpected error: %s", err)
+			return
+		}
+
+		if count != 1 {
+			t.Errorf("Expected 1 run, got %d", count)
+		}
+	})
+}
+
+func TestUntil(t *testing.T) {
+	count := 0
+
+	Status("Calling Until")
+	async.Until(func(done async.Done, args ...interface{}) {
+		done(nil, count == 3)
+	}, func(done async.Done, args ...interface{}) {
+		count++
+		done(nil)
+	}, func(err error, results ...interface{}) {
+		if err != nil {
+			t.Errorf("Unexpected error: %s", err)
+			return
+		}
+
+		if count != 3 {
+			t.Errorf("Expected 3 runs, got %d", count)
+		}
+	})
+}
+
+func TestWhilstError(t *testing.T) {
+	count := 0
+
+	Status("Calling Whilst")
+	async.Whilst(func(done async.Done, args ...interface{}) {
+		done(nil, true)
+	}, func(done async.Done, args ...interface{}) {
+		count++
+		if count == 2 {
+			done(fmt.Errorf("Test error"))
+			return
+		}
+		done(nil)
+	}, func(err error, results ...interface{}) {
+		if err == nil {
+			t.Errorf("Did not throw an error as expected")
+			return
+		}
+
+		if count != 2 {
+			t.Errorf("Whilst did not stop when it errored.")
+		}
+	})
+}
+
+func TestForever(t *testing.T) {
+	count := 0
+
+	Status("Calling Forever")
+	async.Forever(func(done async.Done, args ...interface{}) {
+		count++
+		if count == 10 {
+			done(fmt.Errorf("Stop"))
+			return
+		}
+		done(nil)
+	}, func(err error, results ...interface{}) {
+		if err == nil || count != 10 {
+			t.Errorf("Forever did not stop at the error")
+		}
+	})
+}
@@ -1,6 +1,7 @@
 package async
 
 import (
+	"context"
 	"reflect"
 )
 
@@ -127,25 +128,167 @@ For example, take a look at one of the tests for this function:
     async.MapParallel(str, mapper, final)
   }
 
-The output of mapping in Parallel mode cannot be guaranteed to stay in the
-same order, due to the fact that it may take longer to process some things
-in your map routine. If you need the data to stay in the order it is in, use
-Map instead to ensure it stays in order.
+Even though the values are processed in Parallel mode, the results are kept
+in the same order as the slice. Each value is replaced by the first argument
+passed to its Done function, or nil if there wasn't one, and is stored by its
+index. This means results[i] is always the replacement for data[i], no matter
+which order the routines finished in.
 
 */
 func MapParallel(data interface{}, routine Routine, callbacks ...Done) {
+	MapParallelLimit(data, 0, routine, callbacks...)
+}
+
+/*
+
+MapParallelLimit allows you to manipulate data in a slice in Parallel mode,
+the same as MapParallel, but with no more than limit values being processed
+at the same time. If limit is less than 1, all of the values are processed at
+once.
+
+This is useful when your map routine talks to something that can only handle
+so many requests at once. For example:
+  async.MapParallelLimit(ids, 10, func(done async.Done, args ...interface{}) {
+    user, err := db.FindUser(args[0].(int))
+    done(err, user)
+  }, func(err error, results ...interface{}) {
+    if err != nil {
+      fmt.Printf("Error: %s", err)
+      return
+    }
+
+    fmt.Printf("Users: %+v", results)
+  })
+
+The results are kept in the same order as the slice, the same as
+MapParallel. More documentation on how the limit is handled can be found on
+the RunParallelLimit function.
+
+*/
+func MapParallelLimit(data interface{}, limit int, routine Routine, callbacks ...Done) {
 	var routines []Routine
 
 	d := reflect.ValueOf(data)
 
+	// Each value gets its own slot so that the results can be put back
+	// together in the same order as the slice.
+	slots := make([]interface{}, d.Len())
+
 	for i := 0; i < d.Len(); i++ {
 		v := d.Index(i).Interface()
 		routines = append(routines, func(id int) Routine {
 			return func(done Done, args ...interface{}) {
-				routine(done, v, id)
+				done = func(original Done) Done {
+					return func(err error, args ...interface{}) {
+						if err == nil {
+							slots[id] = args[0]
+						}
+						original(err)
+					}
+				}(done)
+
+				single(routine)(done, v, id)
 			}
 		}(i))
 	}
 
-	Parallel(routines, callbacks...)
+	ParallelLimit(routines, limit, func(err error, args ...interface{}) {
+		var results []interface{}
+
+		if err == nil {
+			results = slots
+		}
+
+		for i := 0; i < len(callbacks); i++ {
+			callbacks[i](err, results...)
+		}
+	})
+}
+
+// single wraps routine so that it always passes exactly one argument to its
+// Done function, which is the first argument that routine passed. This keeps
+// the results of MapParallel lined up with the slice.
+func single(routine Routine) Routine {
+	return func(done Done, args ...interface{}) {
+		routine(func(err error, args ...interface{}) {
+			var first interface{}
+			if len(args) > 0 {
+				first = args[0]
+			}
+			done(err, first)
+		}, args...)
+	}
+}
+
+/*
+
+MapOf allows you to manipulate data in a slice, the same as Map, but with
+types checked at compile time instead of using reflection.
+
+Each value is passed into fn with its index, one after another. The first
+error stops the mapping and is returned. If ctx is cancelled, the mapping
+stops before the next value and the error from ctx is returned.
+
+For example:
+  doubled, err := async.MapOf(ctx, []int{1, 2, 3},
+    func(ctx context.Context, v int, i int) (int, error) {
+      return v * 2, nil
+    })
+
+*/
+func MapOf[T, R any](ctx context.Context, data []T, fn func(context.Context, T, int) (R, error)) ([]R, error) {
+	results := make([]R, len(data))
+
+	for i := 0; i < len(data); i++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		err := protect(func() (err error) {
+			results[i], err = fn(ctx, data[i], i)
+			return err
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return results, nil
+}
+
+/*
+
+MapOfParallel allows you to manipulate data in a slice in Parallel mode, the
+same as MapParallel, but with types checked at compile time instead of using
+reflection.
+
+The results are kept in the same order as the slice. The context given to fn
+is cancelled as soon as one of the values returns an error, and that first
+error is returned once all of the running calls have finished.
+
+*/
+func MapOfParallel[T, R any](ctx context.Context, data []T, fn func(context.Context, T, int) (R, error)) ([]R, error) {
+	return MapOfParallelLimit(ctx, data, 0, fn)
+}
+
+/*
+
+MapOfParallelLimit allows you to manipulate data in a slice in Parallel mode,
+the same as MapOfParallel, but with no more than limit values being processed
+at the same time. If limit is less than 1, all of the values are processed at
+once.
+
+*/
+func MapOfParallelLimit[T, R any](ctx context.Context, data []T, limit int, fn func(context.Context, T, int) (R, error)) ([]R, error) {
+	results := make([]R, len(data))
+
+	err := eachParallel(ctx, len(data), limit, func(ctx context.Context, i int) (err error) {
+		results[i], err = fn(ctx, data[i], i)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return results, nil
 }
@@ -1,8 +1,13 @@
 package async_test
 
 import (
+	"context"
+	"fmt"
 	"github.com/Southern/async"
+	"strconv"
+	"sync"
 	"testing"
+	"time"
 )
 
 func TestMapString(t *testing.T) {
@@ -194,3 +199,206 @@ func TestMapBoolParallel(t *testing.T) {
 
 	async.MapParallel(bools, mapper, final)
 }
+
+func TestMapIntParallelLimit(t *testing.T) {
+	var (
+		mutex   sync.Mutex
+		running int
+		most    int
+	)
+
+	ints := []int{1, 2, 3, 4, 5}
+
+	expects := []int{2, 4, 6, 8, 10}
+
+	mapper := func(done async.Done, args ...interface{}) {
+		Status("Hit int")
+		Status("Args: %+v\n", args)
+
+		mutex.Lock()
+		running++
+		if running > most {
+			most = running
+		}
+		mutex.Unlock()
+
+		time.Sleep(10 * time.Millisecond)
+
+		mutex.Lock()
+		running--
+		mutex.Unlock()
+
+		done(nil, args[0].(int)*2)
+	}
+
+	final := func(err error, results ...interface{}) {
+		Status("Hit int end")
+		Status("Results: %+v\n", results)
+		if len(results) != len(expects) {
+			t.Errorf("Expected %d results, got %d", len(expects), len(results))
+			return
+		}
+		for i := 0; i < len(results); i++ {
+			if results[i] != expects[i] {
+				t.Errorf("Did not map correctly.")
+				break
+			}
+		}
+	}
+
+	async.MapParallelLimit(ints, 2, mapper, final)
+
+	if most > 2 {
+		t.Errorf("Expected at most 2 routines running at once, got %d", most)
+	}
+}
+
+func TestMapParallelOrder(t *testing.T) {
+	ints := []int{1, 2, 3, 4, 5}
+
+	expects := []int{2, 4, 6, 8, 10}
+
+	mapper := func(done async.Done, args ...interface{}) {
+		Status("Hit int")
+		Status("Args: %+v\n", args)
+
+		// Make the first values finish last.
+		time.Sleep(time.Duration(len(ints)-args[1].(int)) * 10 * time.Millisecond)
+		done(nil, args[0].(int)*2)
+	}
+
+	final := func(err error, results ...interface{}) {
+		Status("Hit int end")
+		Status("Results: %+v\n", results)
+		if len(results) != len(expects) {
+			t.Errorf("Expected %d results, got %d", len(expects), len(results))
+			return
+		}
+		for i := 0; i < len(results); i++ {
+			if results[i] != expects[i] {
+				t.Errorf("Results were not kept in order: %+v", results)
+				break
+			}
+		}
+	}
+
+	async.MapParallel(ints, mapper, final)
+}
+
+func TestMapParallelMissingValue(t *testing.T) {
+	ints := []int{1, 2, 3}
+
+	expects := []interface{}{2, nil, 6}
+
+	mapper := func(done async.Done, args ...interface{}) {
+		Status("Hit int")
+		Status("Args: %+v\n", args)
+		switch args[0] {
+		case 2:
+			done(nil)
+		case 3:
+			done(nil, args[0].(int)*2, "extra")
+		default:
+			done(nil, args[0].(int)*2)
+		}
+	}
+
+	final := func(err error, results ...interface{}) {
+		Status("Hit int end")
+		Status("Results: %+v\n", results)
+		if len(results) != len(expects) {
+			t.Errorf("Expected %d results, got %d", len(expects), len(results))
+			return
+		}
+		for i := 0; i < len(results); i++ {
+			if results[i] != expects[i] {
+				t.Errorf("Results were not lined up with the slice: %+v", results)
+				break
+			}
+		}
+	}
+
+	async.MapParallel(ints, mapper, final)
+}
+
+func TestMapOf(t *testing.T) {
+	ints := []int{1, 2, 3, 4, 5}
+
+	expects := []string{"1", "4", "9", "16", "25"}
+
+	results, err := async.MapOf(context.Background(), ints,
+		func(ctx context.Context, v int, i int) (string, error) {
+			Status("Hit int %d at %d", v, i)
+			return strconv.Itoa(v * v), nil
+		})
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+
+	for i := 0; i < len(expects); i++ {
+		if results[i] != expects[i] {
+			t.Errorf("Did not map correctly: %+v", results)
+			break
+		}
+	}
+}
+
+func TestMapOfError(t *testing.T) {
+	count := 0
+
+	_, err := async.MapOf(context.Background(), []int{1, 2, 3},
+		func(ctx context.Context, v int, i int) (int, error) {
+			count++
+			if v == 2 {
+				return 0, fmt.Errorf("Test error")
+			}
+			return v, nil
+		})
+	if err == nil {
+		t.Errorf("Did not throw an error as expected")
+		return
+	}
+
+	if count != 2 {
+		t.Errorf("Mapping did not stop at the error")
+	}
+}
+
+func TestMapOfParallel(t *testing.T) {
+	ints := []int{1, 2, 3, 4, 5}
+
+	expects := []int{2, 4, 6, 8, 10}
+
+	results, err := async.MapOfParallel(context.Background(), ints,
+		func(ctx context.Context, v int, i int) (int, error) {
+			// Make the first values finish last.
+			time.Sleep(time.Duration(len(ints)-i) * 10 * time.Millisecond)
+			return v * 2, nil
+		})
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+
+	for i := 0; i < len(expects); i++ {
+		if results[i] != expects[i] {
+			t.Errorf("Results were not kept in order: %+v", results)
+			break
+		}
+	}
+}
+
+func TestMapOfParallelLimitError(t *testing.T) {
+	_, err := async.MapOfParallelLimit(context.Background(), []int{1, 2, 3}, 2,
+		func(ctx context.Context, v int, i int) (int, error) {
+			if v == 1 {
+				panic("Test panic")
+			}
+			return v, nil
+		})
+
+	if _, ok := err.(*async.PanicError); !ok {
+		t.Errorf("Expected a panic error, got %+v", err)
+	}
+}
@@ -1,5 +1,10 @@
 package async
 
+import (
+	"context"
+	"sync"
+)
+
 /*
 
 Parallel is a shorthand function to List.RunParallel without having to
@@ -15,17 +20,51 @@ func Parallel(routines []Routine, callbacks ...Done) {
 
 /*
 
+ParallelContext is a shorthand function to List.RunParallelContext without
+having to manually create a new list, add the routines, etc.
+
+Each ContextRoutine is given a context that is derived from ctx. That context
+is cancelled as soon as one of the routines returns an error, or when ctx
+itself is cancelled, so that long running routines are able to stop early.
+
+*/
+func ParallelContext(ctx context.Context, routines []ContextRoutine, callbacks ...Done) {
+	ctx, cancel := context.WithCancel(ctx)
+
+	l := New()
+	l.Multiple(withContext(ctx, routines)...)
+
+	l.runParallel(ctx, cancel, parallelMode{cancel: true}, callbacks...)
+}
+
+/*
+
+ParallelLimit is a shorthand function to List.RunParallelLimit without having
+to manually create a new list, add the routines, etc.
+
+*/
+func ParallelLimit(routines []Routine, limit int, callbacks ...Done) {
+	l := New()
+	l.Multiple(routines...)
+
+	l.RunParallelLimit(limit, callbacks...)
+}
+
+/*
+
 RunParallel will run all of the Routine functions from the current list in
 parallel mode.
 
 All of the arguments returned in a Routine's Done function will be combined
-and returned in the callbacks that are provided.
+and returned in the callbacks that are provided, in the same order that the
+routines were added to the list. If you need to know which arguments came
+from which Routine, use RunParallelGrouped instead.
 
 If there is an error, any further results will be discarded but it will not
 immediately exit. It will continue to run all of the other Routine functions
 that were passed into it. This is because by the time the error is sent, the
-goroutines have already been started. At this current time, there is no way
-to cancel a sleep timer in Go.
+goroutines have already been started. If you need your routines to be able
+to stop early, use RunParallelContext or ParallelContext instead.
 
 For example:
   async.Parallel([]async.Routine{
@@ -52,66 +91,394 @@ since an error has already occurred.
 
 */
 func (l *List) RunParallel(callbacks ...Done) {
+	ctx, cancel := context.WithCancel(context.Background())
+	l.runParallel(ctx, cancel, parallelMode{}, callbacks...)
+}
+
+/*
+
+RunParallelContext will run all of the Routine functions from the current list
+in parallel mode, the same as RunParallel, but stops once ctx is cancelled.
+
+If ctx is cancelled before all of the routines have been started, the
+remaining routines are discarded without being ran and the callbacks are
+triggered with the error from ctx. If one of the routines returns an error,
+the context is cancelled for any of the routines that haven't started yet.
+
+Routines that are already running are still waited on before
+RunParallelContext returns, but their results are discarded.
+
+*/
+func (l *List) RunParallelContext(ctx context.Context, callbacks ...Done) {
+	ctx, cancel := context.WithCancel(ctx)
+	l.runParallel(ctx, cancel, parallelMode{cancel: true}, callbacks...)
+}
+
+/*
+
+RunParallelLimit will run all of the Routine functions from the current list
+in parallel mode, the same as RunParallel, but with no more than limit of them
+running at the same time. If limit is less than 1, all of the routines are
+started at once.
+
+The results and errors are handled the same as RunParallel. The only
+difference is that once an error has been returned, none of the routines that
+are still waiting for their turn will be started.
+
+For example, to only ever have 10 database queries running at once:
+  async.ParallelLimit(queries, 10, func(err error, results ...interface{}) {
+    if err != nil {
+      fmt.Printf("Error: %s", err)
+      return
+    }
+
+    fmt.Printf("Results: %s", results)
+  })
+
+*/
+func (l *List) RunParallelLimit(limit int, callbacks ...Done) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	// Without a limit, every routine is started straight away and there is
+	// nothing waiting to be stopped, the same as RunParallel.
+	l.runParallel(ctx, cancel, parallelMode{limit: limit, cancel: limit > 0}, callbacks...)
+}
+
+/*
+
+ParallelGrouped is a shorthand function to List.RunParallelGrouped without
+having to manually create a new list, add the routines, etc.
+
+*/
+func ParallelGrouped(routines []Routine, callbacks ...Done) {
+	l := New()
+	l.Multiple(routines...)
+
+	l.RunParallelGrouped(callbacks...)
+}
+
+/*
+
+RunParallelGrouped will run all of the Routine functions from the current list
+in parallel mode, the same as RunParallel, but the arguments from each Routine
+are kept together instead of being combined.
+
+The callbacks are given one result for each Routine, in the same order that
+the routines were added to the list. Each result is a []interface{} holding
+the arguments that the Routine passed to its Done function.
+
+For example:
+  async.ParallelGrouped([]async.Routine{
+    func(done async.Done, args ...interface{}) {
+      done(nil, "user", 42)
+    },
+    func(done async.Done, args ...interface{}) {
+      done(nil, "settings")
+    },
+  }, func(err error, results ...interface{}) {
+    if err != nil {
+      fmt.Printf("Error: %s", err)
+      return
+    }
+
+    user := results[0].([]interface{})
+    settings := results[1].([]interface{})
+    fmt.Printf("User: %s %d, Settings: %s", user[0], user[1], settings[0])
+  })
+
+*/
+func (l *List) RunParallelGrouped(callbacks ...Done) {
+	ctx, cancel := context.WithCancel(context.Background())
+	l.runParallel(ctx, cancel, parallelMode{grouped: true}, callbacks...)
+}
+
+/*
+
+ParallelSettled is a shorthand function to List.RunParallelSettled without
+having to manually create a new list, add the routines, etc.
+
+*/
+func ParallelSettled(routines []Routine, callbacks ...Done) {
+	l := New()
+	l.Multiple(routines...)
+
+	l.RunParallelSettled(callbacks...)
+}
+
+/*
+
+RunParallelSettled will run all of the Routine functions from the current list
+in parallel mode, the same as RunParallel, but it waits for every Routine to
+finish instead of stopping at the first error.
+
+If any of the routines returned an error, the callbacks are given an Errors
+value holding a *RoutineError for each of them, tagged with the position of
+the Routine in the list. The arguments from all of the routines that
+succeeded are still given to the callbacks, in the same order that the
+routines were added to the list.
+
+For example:
+  async.ParallelSettled(jobs, func(err error, results ...interface{}) {
+    if err != nil {
+      for _, e := range err.(async.Errors) {
+        fmt.Printf("Job %d failed: %s\n", e.(*async.RoutineError).Index, e)
+      }
+    }
+
+    fmt.Printf("Results: %+v", results)
+  })
+
+*/
+func (l *List) RunParallelSettled(callbacks ...Done) {
+	ctx, cancel := context.WithCancel(context.Background())
+	l.runParallel(ctx, cancel, parallelMode{all: true}, callbacks...)
+}
+
+// parallelMode holds the options for how runParallel should run the routines.
+type parallelMode struct {
+	// limit is the most routines that are able to run at once. If it is less
+	// than 1, there is no limit.
+	limit int
+
+	// grouped keeps the arguments from each routine in their own slice,
+	// instead of combining them into one.
+	grouped bool
+
+	// all waits for every routine to finish, even after an error, and
+	// returns all of the errors together.
+	all bool
+
+	// cancel stops any routines that haven't been started yet once one of
+	// them returns an error.
+	cancel bool
+}
+
+// runParallel runs the routines in the list in parallel mode, using the
+// options provided by mode.
+func (l *List) runParallel(ctx context.Context, cancel context.CancelFunc, mode parallelMode, callbacks ...Done) {
 	var (
-		results = make([]interface{}, 0)
+		mutex   sync.Mutex
+		settled bool
+
+		// results holds the arguments for each routine by its position in
+		// the list, so that they can be returned in order.
+		results = make([][]interface{}, l.Len())
+
+		// running is used as a semaphore when there is a limit on how many
+		// routines are able to run at once.
+		running chan struct{}
+
+		// settle makes sure that the callbacks are only ever triggered once,
+		// whether that's from an error, a cancellation or the final results.
+		settle = func() bool {
+			mutex.Lock()
+			defer mutex.Unlock()
 
-		result = make(chan interface{})
+			if settled {
+				return false
+			}
+			settled = true
+			return true
+		}
 
-		_error error
+		// errs holds the error for each routine by its position in the list
+		// when all of the errors are being collected.
+		errs = make([]error, l.Len())
 
 		final = func(err error, results ...interface{}) {
 			for i := 0; i < len(callbacks); i++ {
-				if err != nil {
-					callbacks[i](err)
-				} else {
-					callbacks[i](err, results...)
+				callbacks[i](err, results...)
+			}
+		}
+
+		// fail handles an error from the routine at position id. Unless all
+		// of the errors are being collected, the first error cancels the
+		// context and is sent straight to the callbacks.
+		fail = func(id int, err error) {
+			if mode.all {
+				// Keep the first error for each routine, since anything
+				// later, such as calling done twice, isn't the real cause.
+				mutex.Lock()
+				if errs[id] == nil {
+					errs[id] = &RoutineError{Index: id, Err: err}
 				}
+				mutex.Unlock()
+				return
+			}
+
+			if settle() {
+				if mode.cancel {
+					cancel()
+				}
+				final(err)
 			}
 		}
 	)
 
-	defer close(result)
-
-	l.Wait.Add(l.Len())
+	defer cancel()
 
+	// Report the cancellation of the parent context as soon as it happens,
+	// rather than waiting on the routines that are still running.
+	stop, watched := make(chan struct{}), make(chan struct{})
 	go func() {
-		for {
-			r := <-result
+		defer close(watched)
 
-			if _error != nil {
-				continue
+		select {
+		case <-ctx.Done():
+			if settle() {
+				final(ctx.Err())
 			}
+		case <-stop:
+		}
+	}()
+
+	if mode.limit > 0 {
+		running = make(chan struct{}, mode.limit)
+	}
 
-			switch r.(type) {
-			case error:
-				_error = r.(error)
-				final(_error)
+	for id := 0; l.Len() > 0 && ctx.Err() == nil; id++ {
+		if running != nil {
+			select {
+			case running <- struct{}{}:
+			case <-ctx.Done():
+			}
 
-			case []interface{}:
-				results = append(results, r.([]interface{})...)
+			// An error could have been returned while we were waiting for
+			// a free slot.
+			if ctx.Err() != nil {
+				break
 			}
 		}
-	}()
 
-	for l.Len() > 0 {
 		e := l.Front()
 		_, r := l.Remove(e)
 
-		go r(func(err error, args ...interface{}) {
-			defer l.Wait.Done()
+		l.Wait.Add(1)
+		go func(id int, r Routine) {
+			call(r, func(err error, args ...interface{}) {
+				defer l.Wait.Done()
 
-			if err != nil {
-				result <- err
-				return
-			}
+				if running != nil {
+					defer func() { <-running }()
+				}
+
+				if err != nil {
+					fail(id, err)
+					return
+				}
 
-			result <- args
-		})
+				mutex.Lock()
+				results[id] = args
+				mutex.Unlock()
+			}, func(err error) {
+				fail(id, err)
+			})
+		}(id, r)
 	}
 
+	// Anything that is left in the list was never started, because the
+	// context was cancelled. Throw them away.
+	l.Init()
+
 	l.Wait.Wait()
 
-	if _error == nil {
-		final(nil, results...)
+	close(stop)
+	<-watched
+
+	if settle() {
+		if err := ctx.Err(); err != nil {
+			final(err)
+			return
+		}
+
+		var (
+			args     = make([]interface{}, 0)
+			combined Errors
+		)
+
+		mutex.Lock()
+		for i := 0; i < len(results); i++ {
+			if errs[i] != nil {
+				combined = append(combined, errs[i])
+				continue
+			}
+
+			if mode.grouped {
+				args = append(args, results[i])
+			} else {
+				args = append(args, results[i]...)
+			}
+		}
+		mutex.Unlock()
+
+		if combined != nil {
+			final(combined, args...)
+			return
+		}
+
+		final(nil, args...)
 	}
 }
+
+// eachParallel calls fn for every index from 0 to n in parallel mode, with no
+// more than limit of them running at once. The context given to fn is
+// cancelled as soon as one of them returns an error, and that first error is
+// returned once all of the calls that were started have finished.
+func eachParallel(ctx context.Context, n, limit int, fn func(context.Context, int) error) error {
+	var (
+		wait  sync.WaitGroup
+		once  sync.Once
+		first error
+
+		running chan struct{}
+	)
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	if limit > 0 {
+		running = make(chan struct{}, limit)
+	}
+
+	for i := 0; i < n && ctx.Err() == nil; i++ {
+		if running != nil {
+			select {
+			case running <- struct{}{}:
+			case <-ctx.Done():
+			}
+
+			// An error could have been returned while we were waiting for
+			// a free slot.
+			if ctx.Err() != nil {
+				break
+			}
+		}
+
+		wait.Add(1)
+		go func(i int) {
+			defer wait.Done()
+
+			if running != nil {
+				defer func() { <-running }()
+			}
+
+			err := protect(func() error {
+				return fn(ctx, i)
+			})
+			if err != nil {
+				once.Do(func() {
+					first = err
+					cancel()
+				})
+			}
+		}(i)
+	}
+
+	wait.Wait()
+
+	if first != nil {
+		return first
+	}
+
+	return ctx.Err()
+}
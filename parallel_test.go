@@ -1,8 +1,11 @@
 package async_test
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"github.com/Southern/async"
+	"sync"
 	"testing"
 	"time"
 )
@@ -68,3 +71,346 @@ func TestParallelError(t *testing.T) {
 		t.Errorf("Parallel did not throw an error as expected")
 	})
 }
+
+func TestParallelErrorRunsAll(t *testing.T) {
+	var (
+		mutex   sync.Mutex
+		counter int
+	)
+
+	routines := []async.Routine{
+		func(done async.Done, args ...interface{}) {
+			done(fmt.Errorf("Test error"))
+		},
+	}
+
+	for i := 0; i < 5000; i++ {
+		routines = append(routines, func(done async.Done, args ...interface{}) {
+			mutex.Lock()
+			counter++
+			mutex.Unlock()
+			done(nil)
+		})
+	}
+
+	Status("Calling Parallel")
+	async.Parallel(routines, func(err error, results ...interface{}) {
+		if err == nil {
+			t.Errorf("Did not throw an error as expected")
+		}
+	})
+
+	if counter != 5000 {
+		t.Errorf("Expected all 5000 routines to run, got %d", counter)
+	}
+}
+
+func TestParallelContextError(t *testing.T) {
+	cancelled := make(chan bool, 1)
+
+	Status("Calling ParallelContext")
+	async.ParallelContext(context.Background(), []async.ContextRoutine{
+		func(ctx context.Context, done async.Done, args ...interface{}) {
+			Status("First parallel function")
+			select {
+			case <-ctx.Done():
+				Status("Context was cancelled")
+				cancelled <- true
+				done(ctx.Err())
+			case <-time.After(time.Second):
+				cancelled <- false
+				done(nil, "arg1")
+			}
+		},
+
+		func(ctx context.Context, done async.Done, args ...interface{}) {
+			Status("Second parallel function")
+			done(fmt.Errorf("Test error"))
+		},
+	}, func(err error, results ...interface{}) {
+		if err == nil {
+			t.Errorf("ParallelContext did not throw an error as expected")
+			return
+		}
+
+		Status("ParallelContext exited with error: %+v", err)
+	})
+
+	if !<-cancelled {
+		t.Errorf("Context was not cancelled after an error")
+	}
+}
+
+func TestParallelContextCancel(t *testing.T) {
+	var _err error
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	Status("Calling RunParallelContext")
+	list := async.New()
+	list.Multiple(
+		func(done async.Done, args ...interface{}) {
+			Status("Cancelling context")
+			cancel()
+			done(nil, "arg1")
+		},
+	)
+	list.RunParallelContext(ctx, func(err error, results ...interface{}) {
+		_err = err
+	})
+
+	if _err != context.Canceled {
+		t.Errorf("Expected %s, got %+v", context.Canceled, _err)
+	}
+}
+
+func TestParallelLimit(t *testing.T) {
+	var (
+		mutex   sync.Mutex
+		running int
+		most    int
+	)
+
+	routine := func(done async.Done, args ...interface{}) {
+		mutex.Lock()
+		running++
+		if running > most {
+			most = running
+		}
+		mutex.Unlock()
+
+		time.Sleep(10 * time.Millisecond)
+
+		mutex.Lock()
+		running--
+		mutex.Unlock()
+
+		done(nil, "arg")
+	}
+
+	routines := make([]async.Routine, 0)
+	for i := 0; i < 10; i++ {
+		routines = append(routines, routine)
+	}
+
+	Status("Calling ParallelLimit")
+	async.ParallelLimit(routines, 3, func(err error, results ...interface{}) {
+		if err != nil {
+			t.Errorf("ParallelLimit threw an unexpected error: %+v", err)
+			return
+		}
+
+		if len(results) != 10 {
+			t.Errorf("Expected 10 results, got %d", len(results))
+		}
+	})
+
+	if most > 3 {
+		t.Errorf("Expected at most 3 routines running at once, got %d", most)
+	}
+}
+
+func TestParallelLimitError(t *testing.T) {
+	counter := 0
+
+	Status("Calling RunParallelLimit")
+	list := async.New()
+	list.Multiple(
+		func(done async.Done, args ...interface{}) {
+			Status("Sending error...")
+			done(fmt.Errorf("Test error"))
+		},
+		func(done async.Done, args ...interface{}) {
+			counter++
+			done(nil)
+		},
+	)
+	list.RunParallelLimit(1, func(err error, results ...interface{}) {
+		if err == nil {
+			t.Errorf("RunParallelLimit did not throw an error as expected")
+		}
+	})
+
+	if counter != 0 {
+		t.Errorf("A routine was started after an error was returned")
+	}
+}
+
+func TestParallelGrouped(t *testing.T) {
+	Status("Calling ParallelGrouped")
+	async.ParallelGrouped([]async.Routine{
+		func(done async.Done, args ...interface{}) {
+			Status("First parallel function")
+			time.Sleep(10 * time.Millisecond)
+			done(nil, "arg1", "arg2")
+		},
+
+		func(done async.Done, args ...interface{}) {
+			Status("Second parallel function")
+			done(nil, "arg3")
+		},
+
+		func(done async.Done, args ...interface{}) {
+			Status("Third parallel function")
+			done(nil)
+		},
+	}, func(err error, results ...interface{}) {
+		if err != nil {
+			t.Errorf("ParallelGrouped threw an unexpected error: %+v", err)
+			return
+		}
+
+		Status("ParallelGrouped completed with results: %+v", results)
+
+		if len(results) != 3 {
+			t.Errorf("Expected 3 results, got %d", len(results))
+			return
+		}
+
+		first := results[0].([]interface{})
+		if len(first) != 2 || first[0] != "arg1" || first[1] != "arg2" {
+			t.Errorf("Unexpected first result: %+v", first)
+		}
+
+		second := results[1].([]interface{})
+		if len(second) != 1 || second[0] != "arg3" {
+			t.Errorf("Unexpected second result: %+v", second)
+		}
+
+		if third := results[2].([]interface{}); len(third) != 0 {
+			t.Errorf("Unexpected third result: %+v", third)
+		}
+	})
+}
+
+func TestParallelSettled(t *testing.T) {
+	testError := fmt.Errorf("Test error")
+
+	Status("Calling ParallelSettled")
+	async.ParallelSettled([]async.Routine{
+		func(done async.Done, args ...interface{}) {
+			Status("First parallel function")
+			time.Sleep(10 * time.Millisecond)
+			done(nil, "arg1")
+		},
+
+		func(done async.Done, args ...interface{}) {
+			Status("Second parallel function")
+			done(testError)
+		},
+
+		func(done async.Done, args ...interface{}) {
+			Status("Third parallel function")
+			done(fmt.Errorf("Another error"))
+		},
+
+		func(done async.Done, args ...interface{}) {
+			Status("Fourth parallel function")
+			done(nil, "arg2")
+		},
+	}, func(err error, results ...interface{}) {
+		if err == nil {
+			t.Errorf("ParallelSettled did not throw an error as expected")
+			return
+		}
+
+		Status("ParallelSettled completed with error: %s", err)
+
+		errs, ok := err.(async.Errors)
+		if !ok || len(errs) != 2 {
+			t.Errorf("Expected 2 combined errors, got %+v", err)
+			return
+		}
+
+		if !errors.Is(err, testError) {
+			t.Errorf("Combined error does not contain the original error")
+		}
+
+		var routineError *async.RoutineError
+		if !errors.As(errs[1], &routineError) || routineError.Index != 2 {
+			t.Errorf("Error was not tagged with its routine: %+v", errs[1])
+		}
+
+		if len(results) != 2 || results[0] != "arg1" || results[1] != "arg2" {
+			t.Errorf("Unexpected results: %+v", results)
+		}
+	})
+}
+
+func TestParallelSettledKeepsFirstError(t *testing.T) {
+	testError := fmt.Errorf("Test error")
+
+	Status("Calling ParallelSettled")
+	async.ParallelSettled([]async.Routine{
+		func(done async.Done, args ...interface{}) {
+			Status("Calling done twice...")
+			done(testError)
+			done(fmt.Errorf("Another error"))
+		},
+
+		func(done async.Done, args ...interface{}) {
+			time.Sleep(10 * time.Millisecond)
+			done(nil, "arg1")
+		},
+	}, func(err error, results ...interface{}) {
+		errs, ok := err.(async.Errors)
+		if !ok || len(errs) != 1 {
+			t.Errorf("Expected 1 combined error, got %+v", err)
+			return
+		}
+
+		if !errors.Is(err, testError) {
+			t.Errorf("Expected the first error to be kept, got %+v", err)
+		}
+	})
+}
+
+func TestParallelPanic(t *testing.T) {
+	Status("Calling Parallel")
+	async.Parallel([]async.Routine{
+		func(done async.Done, args ...interface{}) {
+			Status("First parallel function")
+			done(nil, "arg1")
+		},
+
+		func(done async.Done, args ...interface{}) {
+			Status("Second parallel function")
+			panic("Test panic")
+		},
+	}, func(err error, results ...interface{}) {
+		var panicError *async.PanicError
+		if !errors.As(err, &panicError) {
+			t.Errorf("Expected a panic error, got %+v", err)
+			return
+		}
+
+		if panicError.Value != "Test panic" || len(panicError.Stack) == 0 {
+			t.Errorf("Unexpected panic error: %+v", panicError)
+		}
+
+		Status("Parallel exited with error: %s", err)
+	})
+}
+
+func TestParallelDoneCalledTwice(t *testing.T) {
+	Status("Calling Parallel")
+	async.Parallel([]async.Routine{
+		func(done async.Done, args ...interface{}) {
+			Status("Calling done twice...")
+			done(nil, "arg1")
+			done(nil, "arg2")
+		},
+
+		func(done async.Done, args ...interface{}) {
+			time.Sleep(10 * time.Millisecond)
+			done(nil, "arg3")
+		},
+	}, func(err error, results ...interface{}) {
+		if err != async.ErrDoneCalledTwice {
+			t.Errorf("Expected %s, got %+v", async.ErrDoneCalledTwice, err)
+			return
+		}
+
+		Status("Parallel exited with error: %s", err)
+	})
+}
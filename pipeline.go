@@ -0,0 +1,77 @@
+package async
+
+import (
+	"context"
+)
+
+/*
+
+Stage is a typed step of a pipeline, taking an A and turning it into a B.
+Stages are a typed alternative to Waterfall. Where Waterfall passes
+interface{} arguments from one Routine to the next, stages are joined with
+Then, so a mismatch between one stage's output and the next stage's input is
+caught at compile time.
+
+For example:
+  parse := async.Stage[string, int](func(ctx context.Context, s string) (int, error) {
+    return strconv.Atoi(s)
+  })
+
+  double := async.Stage[int, int](func(ctx context.Context, i int) (int, error) {
+    return i * 2, nil
+  })
+
+  format := async.Stage[int, string](func(ctx context.Context, i int) (string, error) {
+    return fmt.Sprintf("Result: %d", i), nil
+  })
+
+  result, err := async.Then(async.Then(parse, double), format).Run(ctx, "21")
+
+*/
+type Stage[A, B any] func(context.Context, A) (B, error)
+
+/*
+
+Then joins two stages together, so that the output of first is the input of
+next. The Stage that is returned can be joined to other stages with Then
+again.
+
+As with Waterfall, the first error stops the pipeline and none of the stages
+after it are ran.
+
+*/
+func Then[A, B, C any](first Stage[A, B], next Stage[B, C]) Stage[A, C] {
+	return func(ctx context.Context, in A) (C, error) {
+		var zero C
+
+		mid, err := first.Run(ctx, in)
+		if err != nil {
+			return zero, err
+		}
+
+		return next.Run(ctx, mid)
+	}
+}
+
+/*
+
+Run runs the Stage with the input provided and returns its output.
+
+If ctx has already been cancelled, the Stage isn't ran and the error from ctx
+is returned. Any panic inside of the Stage is returned as a *PanicError.
+
+*/
+func (s Stage[A, B]) Run(ctx context.Context, in A) (B, error) {
+	var out B
+
+	if err := ctx.Err(); err != nil {
+		return out, err
+	}
+
+	err := protect(func() (err error) {
+		out, err = s(ctx, in)
+		return err
+	})
+
+	return out, err
+}
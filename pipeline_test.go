@@ -0,0 +1,67 @@
+package async_test
+
+import (
+	"context"
+	"fmt"
+	"github.com/Southern/async"
+	"strconv"
+	"testing"
+)
+
+var (
+	parse = async.Stage[string, int](func(ctx context.Context, s string) (int, error) {
+		Status("Parsing %s", s)
+		return strconv.Atoi(s)
+	})
+
+	double = async.Stage[int, int](func(ctx context.Context, i int) (int, error) {
+		Status("Doubling %d", i)
+		return i * 2, nil
+	})
+
+	format = async.Stage[int, string](func(ctx context.Context, i int) (string, error) {
+		Status("Formatting %d", i)
+		return fmt.Sprintf("Result: %d", i), nil
+	})
+)
+
+func TestPipeline(t *testing.T) {
+	result, err := async.Then(async.Then(parse, double), format).Run(context.Background(), "21")
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+
+	if result != "Result: 42" {
+		t.Errorf("Unexpected result: %s", result)
+	}
+}
+
+func TestPipelineError(t *testing.T) {
+	called := false
+
+	last := async.Stage[int, int](func(ctx context.Context, i int) (int, error) {
+		called = true
+		return i, nil
+	})
+
+	_, err := async.Then(parse, last).Run(context.Background(), "not a number")
+	if err == nil {
+		t.Errorf("Did not throw an error as expected")
+		return
+	}
+
+	if called {
+		t.Errorf("The pipeline did not stop when it errored.")
+	}
+}
+
+func TestPipelineCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := async.Then(parse, double).Run(ctx, "21")
+	if err != context.Canceled {
+		t.Errorf("Expected %s, got %+v", context.Canceled, err)
+	}
+}
@@ -0,0 +1,138 @@
+package async
+
+/*
+
+PriorityQueue is a Queue where each task is pushed with a priority. Tasks with
+a higher priority are given to workers first, and tasks with the same priority
+are given to workers in the order that they were pushed.
+
+It inherits all of the functionality of Queue, with a minor tweak to Push.
+Instead of returning the queue, Push returns a PriorityItem that can be used
+to look at or change the priority of the task while it is waiting.
+
+For example:
+  q := async.NewPriorityQueue(worker, 5)
+
+  q.Push(backfill, 0)
+  item := q.Push(report, 1)
+  q.Push(request, 10)
+
+  // The report is now needed straight away.
+  q.SetPriority(item, 20)
+
+Unshift still puts a task at the very front of the queue, and it stays ahead
+of every priority, including tasks that are pushed after it. Pushing through
+the embedded Queue, such as with q.Queue.Push, adds the task with a priority
+of 0.
+
+*/
+type PriorityQueue struct {
+	*Queue
+}
+
+/*
+
+PriorityItem is a task that is waiting in a PriorityQueue.
+
+*/
+type PriorityItem struct {
+	queue *PriorityQueue
+	task  *task
+}
+
+// NewPriorityQueue will create a new PriorityQueue instance. If concurrency is
+// less than 1, only one task will be worked on at a time.
+func NewPriorityQueue(worker Routine, concurrency int) *PriorityQueue {
+	q := &PriorityQueue{
+		Queue: NewQueue(worker, concurrency),
+	}
+	q.place = q.insert
+
+	return q
+}
+
+/*
+
+Push adds a task to the queue with the priority provided. It is placed after
+every waiting task with the same or a higher priority. The callbacks are
+triggered once a worker has finished with the task.
+
+*/
+func (q *PriorityQueue) Push(data interface{}, priority int, callbacks ...Done) *PriorityItem {
+	t := &task{data: data, callbacks: callbacks, priority: priority}
+
+	q.add(t, q.insert)
+
+	return &PriorityItem{q, t}
+}
+
+/*
+
+Pending returns all of the tasks that are waiting to be given to a worker, in
+the order that they will be given out.
+
+*/
+func (q *PriorityQueue) Pending() []*PriorityItem {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	items := make([]*PriorityItem, 0, q.tasks.Len())
+	for e := q.tasks.Front(); e != nil; e = e.Next() {
+		items = append(items, &PriorityItem{q, e.Value.(*task)})
+	}
+
+	return items
+}
+
+/*
+
+SetPriority changes the priority of a task that is still waiting. The task is
+moved after every waiting task with the same or a higher priority, the same
+as if it had just been pushed.
+
+Returns false if the task has already been given to a worker, or the queue
+was killed.
+
+*/
+func (q *PriorityQueue) SetPriority(item *PriorityItem, priority int) bool {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	t := item.task
+	if t.element == nil {
+		return false
+	}
+
+	q.tasks.Remove(t.element)
+	t.priority, t.front = priority, false
+	q.insert(t)
+
+	return true
+}
+
+// insert places the task after the last waiting task with the same or a
+// higher priority, and after any task that was unshifted. The queue must be
+// locked.
+func (q *PriorityQueue) insert(t *task) {
+	for e := q.tasks.Back(); e != nil; e = e.Prev() {
+		if other := e.Value.(*task); other.front || other.priority >= t.priority {
+			t.element = q.tasks.InsertAfter(t, e)
+			return
+		}
+	}
+
+	t.element = q.tasks.PushFront(t)
+}
+
+// Data returns the data that was pushed for the task
+func (i *PriorityItem) Data() interface{} {
+	return i.task.data
+}
+
+// Priority returns the current priority of the task
+func (i *PriorityItem) Priority() int {
+	i.queue.mutex.Lock()
+	defer i.queue.mutex.Unlock()
+
+	return i.task.priority
+}
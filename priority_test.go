@@ -0,0 +1,117 @@
+package async_test
+
+import (
+	"github.com/Southern/async"
+	"testing"
+)
+
+func TestPriorityQueue(t *testing.T) {
+	var order []interface{}
+
+	drained := make(chan bool)
+
+	Status("Creating priority queue")
+	q := async.NewPriorityQueue(func(done async.Done, args ...interface{}) {
+		order = append(order, args[0])
+		done(nil)
+	}, 1)
+	q.Drain = func() {
+		drained <- true
+	}
+
+	q.Pause()
+	q.Push("low1", 0)
+	q.Push("high1", 10)
+	q.Push("low2", 0)
+	q.Push("high2", 10)
+	q.Push("medium", 5)
+
+	pending := q.Pending()
+	if len(pending) != 5 || pending[0].Data() != "high1" || pending[4].Data() != "low2" {
+		t.Errorf("Tasks are not in priority order")
+		return
+	}
+
+	q.Resume()
+	<-drained
+
+	expects := []string{"high1", "high2", "medium", "low1", "low2"}
+	for i := 0; i < len(expects); i++ {
+		if order[i] != expects[i] {
+			t.Errorf("Tasks ran in the wrong order: %+v", order)
+			break
+		}
+	}
+}
+
+func TestPriorityQueueSetPriority(t *testing.T) {
+	var order []interface{}
+
+	drained := make(chan bool)
+
+	Status("Creating priority queue")
+	q := async.NewPriorityQueue(func(done async.Done, args ...interface{}) {
+		order = append(order, args[0])
+		done(nil)
+	}, 1)
+	q.Drain = func() {
+		drained <- true
+	}
+
+	q.Pause()
+	q.Push("first", 5)
+	item := q.Push("second", 1)
+
+	Status("Changing priority")
+	if !q.SetPriority(item, 10) || item.Priority() != 10 {
+		t.Errorf("Priority was not changed")
+		return
+	}
+
+	q.Resume()
+	<-drained
+
+	if len(order) != 2 || order[0] != "second" {
+		t.Errorf("Tasks ran in the wrong order: %+v", order)
+	}
+
+	if q.SetPriority(item, 1) {
+		t.Errorf("Priority was changed after the task was finished")
+	}
+}
+
+func TestPriorityQueueUnshift(t *testing.T) {
+	var order []interface{}
+
+	drained := make(chan bool)
+
+	Status("Creating priority queue")
+	q := async.NewPriorityQueue(func(done async.Done, args ...interface{}) {
+		order = append(order, args[0])
+		done(nil)
+	}, 1)
+	q.Drain = func() {
+		drained <- true
+	}
+
+	q.Pause()
+	q.Unshift("unshifted")
+	q.Push("high", 5)
+	q.Push("low", -1)
+	q.Queue.Push("plain")
+
+	q.Resume()
+	<-drained
+
+	expects := []string{"unshifted", "high", "plain", "low"}
+	if len(order) != len(expects) {
+		t.Errorf("Expected %d tasks to run, got %d", len(expects), len(order))
+		return
+	}
+	for i := 0; i < len(expects); i++ {
+		if order[i] != expects[i] {
+			t.Errorf("Tasks ran in the wrong order: %+v", order)
+			break
+		}
+	}
+}
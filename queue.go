@@ -0,0 +1,304 @@
+package async
+
+import (
+	"container/list"
+	"sync"
+)
+
+// task is a single item of work waiting in a Queue.
+type task struct {
+	data      interface{}
+	callbacks []Done
+
+	// priority is only used by PriorityQueue, and element is the task's
+	// place in the queue while it is waiting.
+	priority int
+	element  *list.Element
+
+	// front is set for tasks added with Unshift, so that PriorityQueue can
+	// keep them ahead of every priority.
+	front bool
+}
+
+/*
+
+Queue is used to run a worker Routine over tasks that are pushed into it over
+time, with no more than a set number of tasks being worked on at once. Unlike
+a List, a Queue can be used for as long as you need it.
+
+The worker is called with each task as its only argument, and the callbacks
+that were pushed with the task are triggered with whatever the worker passes
+to its Done function.
+
+For example:
+  q := async.NewQueue(func(done async.Done, args ...interface{}) {
+    err := send(args[0].(Email))
+    done(err)
+  }, 5)
+
+  q.Drain = func() {
+    fmt.Println("All emails have been sent")
+  }
+
+  q.Push(email, func(err error, results ...interface{}) {
+    if err != nil {
+      fmt.Printf("Error: %s", err)
+    }
+  })
+
+The Saturated, Empty and Drain hooks are optional, and should be set before
+any tasks are pushed.
+
+*/
+type Queue struct {
+	// Saturated is called when the number of tasks being worked on reaches the
+	// concurrency of the queue.
+	Saturated func()
+
+	// Empty is called when the last waiting task is given to a worker.
+	Empty func()
+
+	// Drain is called when the last task has been finished by a worker and
+	// all of its callbacks have been triggered.
+	Drain func()
+
+	mutex       sync.Mutex
+	worker      Routine
+	concurrency int
+	tasks       *list.List
+	running     int
+	finishing   int
+	paused      bool
+	killed      bool
+
+	// place puts a pushed task into tasks while the queue is locked. It is
+	// only set by PriorityQueue, so that pushing through the embedded Queue
+	// still keeps the tasks in order of priority.
+	place func(*task)
+}
+
+// NewQueue will create a new Queue instance. If concurrency is less than 1,
+// only one task will be worked on at a time.
+func NewQueue(worker Routine, concurrency int) *Queue {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	return &Queue{
+		worker:      worker,
+		concurrency: concurrency,
+		tasks:       list.New(),
+	}
+}
+
+/*
+
+Push adds a task to the end of the queue. The callbacks are triggered once a
+worker has finished with the task.
+
+Returns the queue for chaining commands.
+
+*/
+func (q *Queue) Push(data interface{}, callbacks ...Done) *Queue {
+	return q.add(&task{data: data, callbacks: callbacks}, func(t *task) {
+		if q.place != nil {
+			q.place(t)
+			return
+		}
+		t.element = q.tasks.PushBack(t)
+	})
+}
+
+/*
+
+Unshift adds a task to the front of the queue, so that it is the next one to
+be given to a worker. The callbacks are triggered once a worker has finished
+with the task.
+
+Returns the queue for chaining commands.
+
+*/
+func (q *Queue) Unshift(data interface{}, callbacks ...Done) *Queue {
+	return q.add(&task{data: data, callbacks: callbacks, front: true}, func(t *task) {
+		t.element = q.tasks.PushFront(t)
+	})
+}
+
+/*
+
+Pause stops any more tasks from being given to workers until Resume is
+called. Tasks that are already being worked on will still finish.
+
+Returns the queue for chaining commands.
+
+*/
+func (q *Queue) Pause() *Queue {
+	q.mutex.Lock()
+	q.paused = true
+	q.mutex.Unlock()
+
+	return q
+}
+
+/*
+
+Resume starts giving tasks to workers again after Pause was called.
+
+Returns the queue for chaining commands.
+
+*/
+func (q *Queue) Resume() *Queue {
+	q.mutex.Lock()
+	q.paused = false
+	q.mutex.Unlock()
+
+	q.process()
+	return q
+}
+
+/*
+
+Kill stops the queue for good. All of the tasks that are still waiting are
+removed, and their callbacks are triggered with ErrKilled. Tasks that are
+already being worked on will still finish, but Drain won't be called. Any
+task that is pushed after the queue was killed is rejected with ErrKilled.
+
+*/
+func (q *Queue) Kill() {
+	q.mutex.Lock()
+	q.killed = true
+	tasks := q.tasks
+	q.tasks = list.New()
+	for e := tasks.Front(); e != nil; e = e.Next() {
+		e.Value.(*task).element = nil
+	}
+	q.mutex.Unlock()
+
+	for e := tasks.Front(); e != nil; e = e.Next() {
+		e.Value.(*task).finish(ErrKilled)
+	}
+}
+
+// Length returns the number of tasks waiting to be given to a worker
+func (q *Queue) Length() int {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	return q.tasks.Len()
+}
+
+// Running returns the number of tasks currently being worked on
+func (q *Queue) Running() int {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	return q.running
+}
+
+// Idle returns whether there are no tasks waiting or being worked on
+func (q *Queue) Idle() bool {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	return q.tasks.Len() == 0 && q.running == 0
+}
+
+// Paused returns whether the queue has been paused
+func (q *Queue) Paused() bool {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	return q.paused
+}
+
+// add inserts the task into the queue with insert, and starts it if there is
+// a worker free.
+func (q *Queue) add(t *task, insert func(*task)) *Queue {
+	q.mutex.Lock()
+	if q.killed {
+		q.mutex.Unlock()
+		t.finish(ErrKilled)
+		return q
+	}
+	insert(t)
+	q.mutex.Unlock()
+
+	q.process()
+	return q
+}
+
+// process gives waiting tasks to workers until there are no more tasks, or no
+// more free workers.
+func (q *Queue) process() {
+	var (
+		started   []*task
+		saturated bool
+		empty     bool
+	)
+
+	q.mutex.Lock()
+	for !q.paused && !q.killed && q.running < q.concurrency && q.tasks.Len() > 0 {
+		t := q.tasks.Remove(q.tasks.Front()).(*task)
+		t.element = nil
+
+		started = append(started, t)
+		q.running++
+
+		saturated = q.running == q.concurrency
+		empty = q.tasks.Len() == 0
+	}
+	q.mutex.Unlock()
+
+	if len(started) == 0 {
+		return
+	}
+
+	if saturated && q.Saturated != nil {
+		q.Saturated()
+	}
+
+	if empty && q.Empty != nil {
+		q.Empty()
+	}
+
+	for i := 0; i < len(started); i++ {
+		go q.work(started[i])
+	}
+}
+
+// work runs the worker for a single task, and moves on to the next task once
+// it is finished.
+func (q *Queue) work(t *task) {
+	call(q.worker, func(err error, args ...interface{}) {
+		q.mutex.Lock()
+		q.running--
+		q.finishing++
+		q.mutex.Unlock()
+
+		t.finish(err, args...)
+
+		q.process()
+
+		// The callbacks could have pushed more tasks, and other workers
+		// could still be triggering theirs, so only check whether the queue
+		// is drained once they are all done.
+		q.mutex.Lock()
+		q.finishing--
+		drained := q.running == 0 && q.finishing == 0 && q.tasks.Len() == 0 && !q.killed
+		q.mutex.Unlock()
+
+		if drained && q.Drain != nil {
+			q.Drain()
+		}
+	}, func(err error) {
+		// The task's callbacks have already been triggered, so there is
+		// nowhere left to report anything that happens after that.
+	}, t.data)
+}
+
+// finish triggers the task's callbacks.
+func (t *task) finish(err error, args ...interface{}) {
+	for i := 0; i < len(t.callbacks); i++ {
+		t.callbacks[i](err, args...)
+	}
+}
@@ -0,0 +1,203 @@
+package async_test
+
+import (
+	"fmt"
+	"github.com/Southern/async"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestQueue(t *testing.T) {
+	var (
+		mutex   sync.Mutex
+		results []interface{}
+		most    int
+	)
+
+	drained := make(chan bool)
+
+	Status("Creating queue")
+	q := async.NewQueue(func(done async.Done, args ...interface{}) {
+		Status("Working on %+v", args)
+		time.Sleep(5 * time.Millisecond)
+		done(nil, args[0].(int)*2)
+	}, 2)
+
+	q.Saturated = func() {
+		Status("Queue is saturated")
+	}
+	q.Drain = func() {
+		Status("Queue is drained")
+		drained <- true
+	}
+
+	for i := 1; i <= 5; i++ {
+		q.Push(i, func(err error, args ...interface{}) {
+			if err != nil {
+				t.Errorf("Unexpected error: %s", err)
+				return
+			}
+
+			mutex.Lock()
+			results = append(results, args[0])
+			if running := q.Running(); running > most {
+				most = running
+			}
+			mutex.Unlock()
+		})
+	}
+
+	<-drained
+
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	if len(results) != 5 {
+		t.Errorf("Expected 5 results, got %d", len(results))
+	}
+
+	if most > 2 {
+		t.Errorf("Expected at most 2 tasks running at once, got %d", most)
+	}
+
+	if !q.Idle() {
+		t.Errorf("Queue should be idle")
+	}
+}
+
+func TestQueueDrainAfterPush(t *testing.T) {
+	var (
+		mutex   sync.Mutex
+		results []interface{}
+	)
+
+	drained := make(chan int, 2)
+
+	Status("Creating queue")
+	q := async.NewQueue(func(done async.Done, args ...interface{}) {
+		time.Sleep(5 * time.Millisecond)
+		done(nil, args[0])
+	}, 1)
+
+	q.Drain = func() {
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		Status("Queue is drained with results: %+v", results)
+		drained <- len(results)
+	}
+
+	record := func(err error, args ...interface{}) {
+		mutex.Lock()
+		results = append(results, args[0])
+		mutex.Unlock()
+	}
+
+	q.Push(1, func(err error, args ...interface{}) {
+		record(err, args...)
+
+		// Pushing from a callback should keep the queue from draining.
+		q.Push(2, record)
+	})
+
+	if n := <-drained; n != 2 {
+		t.Errorf("Queue drained with %d results instead of 2", n)
+	}
+
+	time.Sleep(10 * time.Millisecond)
+	if len(drained) != 0 {
+		t.Errorf("Queue drained more than once")
+	}
+}
+
+func TestQueuePauseResume(t *testing.T) {
+	var order []interface{}
+
+	drained := make(chan bool)
+
+	Status("Creating queue")
+	q := async.NewQueue(func(done async.Done, args ...interface{}) {
+		order = append(order, args[0])
+		done(nil)
+	}, 1)
+	q.Drain = func() {
+		drained <- true
+	}
+
+	Status("Pausing queue")
+	q.Pause().Push("second").Push("third").Unshift("first")
+
+	if q.Length() != 3 || q.Running() != 0 || !q.Paused() {
+		t.Errorf("Tasks were started while the queue was paused")
+		return
+	}
+
+	Status("Resuming queue")
+	q.Resume()
+	<-drained
+
+	if len(order) != 3 || order[0] != "first" || order[2] != "third" {
+		t.Errorf("Tasks ran in the wrong order: %+v", order)
+	}
+}
+
+func TestQueueKill(t *testing.T) {
+	var (
+		mutex  sync.Mutex
+		killed int
+	)
+
+	release := make(chan bool)
+
+	Status("Creating queue")
+	q := async.NewQueue(func(done async.Done, args ...interface{}) {
+		<-release
+		done(nil)
+	}, 1)
+	q.Drain = func() {
+		t.Errorf("Drain was called after the queue was killed")
+	}
+
+	count := func(err error, args ...interface{}) {
+		if err == async.ErrKilled {
+			mutex.Lock()
+			killed++
+			mutex.Unlock()
+		}
+	}
+
+	q.Push(1, count).Push(2, count).Push(3, count)
+
+	Status("Killing queue")
+	q.Kill()
+	q.Push(4, count)
+	close(release)
+
+	// Give the running task a chance to finish.
+	time.Sleep(10 * time.Millisecond)
+
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	if killed != 3 {
+		t.Errorf("Expected 3 killed tasks, got %d", killed)
+	}
+}
+
+func TestQueueError(t *testing.T) {
+	done := make(chan error)
+
+	Status("Creating queue")
+	q := async.NewQueue(func(done async.Done, args ...interface{}) {
+		done(fmt.Errorf("Test error"))
+	}, 1)
+
+	q.Push(1, func(err error, args ...interface{}) {
+		done <- err
+	})
+
+	if err := <-done; err == nil {
+		t.Errorf("Did not throw an error as expected")
+	}
+}
@@ -0,0 +1,77 @@
+package async
+
+import (
+	"reflect"
+)
+
+/*
+
+Reduce allows you to boil the data in a slice down to a single value in
+Waterfall mode.
+
+Each Routine will be called with the current memo, and the value and index of
+the current position in the slice. The first argument passed to the Done
+function becomes the memo for the next value, starting with the memo that
+was provided. When calling the Done function, an error will cause the
+reducing to immediately exit.
+
+For example:
+  async.Reduce([]int{1, 2, 3}, 0, func(done async.Done, args ...interface{}) {
+    done(nil, args[0].(int)+args[1].(int))
+  }, func(err error, results ...interface{}) {
+    if err != nil {
+      fmt.Printf("Error: %s", err)
+      return
+    }
+
+    fmt.Printf("Sum: %d", results[0])
+  })
+
+*/
+func Reduce(data interface{}, memo interface{}, routine Routine, callbacks ...Done) {
+	reduce(data, memo, false, routine, callbacks...)
+}
+
+/*
+
+ReduceRight allows you to boil the data in a slice down to a single value in
+Waterfall mode, the same as Reduce, but starting from the end of the slice.
+
+Each Routine is still given the original index of the value in the slice.
+
+*/
+func ReduceRight(data interface{}, memo interface{}, routine Routine, callbacks ...Done) {
+	reduce(data, memo, true, routine, callbacks...)
+}
+
+func reduce(data interface{}, memo interface{}, right bool, routine Routine, callbacks ...Done) {
+	// The first routine only hands the starting memo to the next one.
+	routines := []Routine{
+		func(done Done, args ...interface{}) {
+			done(nil, memo)
+		},
+	}
+
+	d := reflect.ValueOf(data)
+
+	for i := 0; i < d.Len(); i++ {
+		id := i
+		if right {
+			id = d.Len() - 1 - i
+		}
+
+		v := d.Index(id).Interface()
+		routines = append(routines, func(id int, v interface{}) Routine {
+			return func(done Done, args ...interface{}) {
+				var memo interface{}
+				if len(args) > 0 {
+					memo = args[0]
+				}
+
+				routine(done, memo, v, id)
+			}
+		}(id, v))
+	}
+
+	Waterfall(routines, callbacks...)
+}
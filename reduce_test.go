@@ -0,0 +1,82 @@
+package async_test
+
+import (
+	"fmt"
+	"github.com/Southern/async"
+	"testing"
+)
+
+func TestReduce(t *testing.T) {
+	ints := []int{1, 2, 3, 4, 5}
+
+	reducer := func(done async.Done, args ...interface{}) {
+		Status("Hit int")
+		Status("Args: %+v\n", args)
+		done(nil, args[0].(int)+args[1].(int))
+	}
+
+	final := func(err error, results ...interface{}) {
+		Status("Results: %+v\n", results)
+		if err != nil {
+			t.Errorf("Unexpected error: %s", err)
+			return
+		}
+
+		if results[0] != 15 {
+			t.Errorf("Did not reduce correctly.")
+		}
+	}
+
+	async.Reduce(ints, 0, reducer, final)
+}
+
+func TestReduceRight(t *testing.T) {
+	str := []string{"a", "b", "c"}
+
+	reducer := func(done async.Done, args ...interface{}) {
+		Status("Hit string")
+		Status("Args: %+v\n", args)
+		done(nil, args[0].(string)+args[1].(string)+fmt.Sprint(args[2]))
+	}
+
+	final := func(err error, results ...interface{}) {
+		Status("Results: %+v\n", results)
+		if err != nil {
+			t.Errorf("Unexpected error: %s", err)
+			return
+		}
+
+		if results[0] != ">c2b1a0" {
+			t.Errorf("Did not reduce correctly: %s", results[0])
+		}
+	}
+
+	async.ReduceRight(str, ">", reducer, final)
+}
+
+func TestReduceError(t *testing.T) {
+	reducer := func(done async.Done, args ...interface{}) {
+		if args[2] == 1 {
+			done(fmt.Errorf("Test error"))
+			return
+		}
+		if args[2] == 2 {
+			t.Errorf("Reduce did not stop when it errored.")
+		}
+		done(nil, args[0])
+	}
+
+	async.Reduce([]int{1, 2, 3}, 0, reducer, func(err error, results ...interface{}) {
+		if err == nil {
+			t.Errorf("Did not throw an error as expected")
+		}
+	})
+}
+
+func TestReduceEmpty(t *testing.T) {
+	async.Reduce([]int{}, 10, nil, func(err error, results ...interface{}) {
+		if err != nil || results[0] != 10 {
+			t.Errorf("Expected the memo to be returned, got %+v", results)
+		}
+	})
+}
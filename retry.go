@@ -0,0 +1,162 @@
+package async
+
+import (
+	"math/rand"
+	"sync"
+	"time"
+)
+
+// DefaultRetryAttempts is the number of attempts Retry makes when the
+// RetryPolicy doesn't set Attempts.
+const DefaultRetryAttempts = 5
+
+/*
+
+RetryPolicy is used to describe how Retry should call a Routine again after it
+returns an error.
+
+The delay before each retry starts at Delay, and is multiplied by Multiplier
+after every attempt, up to MaxDelay. Jitter is the fraction of the delay, from
+0 to 1, that is randomly taken off of each delay so that many routines
+retrying at the same time don't all hit the same thing at once.
+
+*/
+type RetryPolicy struct {
+	// Attempts is the most number of times the Routine will be called. If it
+	// is less than 1, DefaultRetryAttempts is used.
+	Attempts int
+
+	// Delay is how long to wait before the first retry.
+	Delay time.Duration
+
+	// MaxDelay is the longest that the delay is able to grow to. If it is 0,
+	// there is no limit.
+	MaxDelay time.Duration
+
+	// Multiplier is how much the delay grows after each attempt. If it is 0,
+	// the delay doubles after each attempt.
+	Multiplier float64
+
+	// Jitter is the fraction of each delay that is randomised.
+	Jitter float64
+
+	// Retryable decides whether an error should be retried. If it is nil,
+	// every error is retried.
+	Retryable func(error) bool
+}
+
+// delay returns how long to wait before the attempt after the one provided.
+func (p RetryPolicy) delay(attempt int) time.Duration {
+	multiplier := p.Multiplier
+	if multiplier == 0 {
+		multiplier = 2
+	}
+
+	delay := float64(p.Delay)
+	for i := 1; i < attempt; i++ {
+		delay *= multiplier
+		if p.MaxDelay > 0 && delay > float64(p.MaxDelay) {
+			break
+		}
+	}
+
+	if p.MaxDelay > 0 && delay > float64(p.MaxDelay) {
+		delay = float64(p.MaxDelay)
+	}
+
+	if p.Jitter > 0 {
+		delay -= delay * p.Jitter * rand.Float64()
+	}
+
+	return time.Duration(delay)
+}
+
+/*
+
+Retry wraps a Routine so that it is called again, with the same arguments,
+whenever it returns an error. Once the Routine succeeds, or the policy gives
+up, the result of the last attempt is sent to the Done function.
+
+The Routine that is returned can be used anywhere that a normal Routine can,
+such as Series, Parallel, Waterfall and Map.
+
+For example:
+  async.Parallel([]async.Routine{
+    async.Retry(fetchUser, async.RetryPolicy{
+      Attempts: 3,
+      Delay:    100 * time.Millisecond,
+      Jitter:   0.5,
+      Retryable: func(err error) bool {
+        return err != ErrNotFound
+      },
+    }),
+    fetchSettings,
+  }, func(err error, results ...interface{}) {
+    // ...
+  })
+
+*/
+func Retry(routine Routine, policy RetryPolicy) Routine {
+	attempts := policy.Attempts
+	if attempts < 1 {
+		attempts = DefaultRetryAttempts
+	}
+
+	return func(done Done, args ...interface{}) {
+		var attempt func(n int)
+
+		attempt = func(n int) {
+			var (
+				mutex sync.Mutex
+
+				// handled is set once this attempt has either finished or
+				// scheduled the next one. retrying is set for the latter.
+				handled  bool
+				retrying bool
+			)
+
+			call(routine, func(err error, results ...interface{}) {
+				retry := err != nil && n < attempts &&
+					(policy.Retryable == nil || policy.Retryable(err))
+
+				mutex.Lock()
+				if handled {
+					mutex.Unlock()
+					return
+				}
+				handled, retrying = true, retry
+				mutex.Unlock()
+
+				if !retry {
+					done(err, results...)
+					return
+				}
+
+				delay := policy.delay(n)
+				if delay <= 0 {
+					attempt(n + 1)
+					return
+				}
+
+				time.AfterFunc(delay, func() {
+					attempt(n + 1)
+				})
+			}, func(err error) {
+				mutex.Lock()
+				skip := handled && retrying
+				handled = true
+				mutex.Unlock()
+
+				// Once the next attempt has been scheduled, it is the one
+				// that decides what is sent to done. Otherwise, let the
+				// runner know about anything that happened after this
+				// attempt finished.
+				if !skip {
+					done(err)
+				}
+			}, args...)
+		}
+
+		attempt(1)
+	}
+}
@@ -0,0 +1,146 @@
+package async_test
+
+import (
+	"fmt"
+	"github.com/Southern/async"
+	"testing"
+	"time"
+)
+
+func TestRetry(t *testing.T) {
+	attempts := 0
+
+	Status("Calling Series with a routine that fails twice")
+	async.Series([]async.Routine{
+		async.Retry(func(done async.Done, args ...interface{}) {
+			attempts++
+			Status("Attempt %d", attempts)
+			if attempts < 3 {
+				done(fmt.Errorf("Test error"))
+				return
+			}
+			done(nil)
+		}, async.RetryPolicy{
+			Attempts: 5,
+			Delay:    time.Millisecond,
+			Jitter:   0.5,
+		}),
+	}, func(err error, results ...interface{}) {
+		if err != nil {
+			t.Errorf("Unexpected error: %s", err)
+			return
+		}
+
+		if attempts != 3 {
+			t.Errorf("Expected 3 attempts, got %d", attempts)
+		}
+	})
+}
+
+func TestRetryGiveUp(t *testing.T) {
+	attempts := 0
+
+	Status("Calling Waterfall with a routine that always fails")
+	async.Waterfall([]async.Routine{
+		async.Retry(func(done async.Done, args ...interface{}) {
+			attempts++
+			Status("Attempt %d with arguments: %+v", attempts, args)
+			done(fmt.Errorf("Test error"))
+		}, async.RetryPolicy{
+			Attempts: 3,
+			Delay:    time.Millisecond,
+			MaxDelay: 2 * time.Millisecond,
+		}),
+	}, func(err error, results ...interface{}) {
+		if err == nil {
+			t.Errorf("Did not throw an error as expected")
+			return
+		}
+
+		if attempts != 3 {
+			t.Errorf("Expected 3 attempts, got %d", attempts)
+		}
+	})
+}
+
+func TestRetryNotRetryable(t *testing.T) {
+	attempts := 0
+	fatal := fmt.Errorf("Fatal error")
+
+	Status("Calling Series with a routine that can't be retried")
+	async.Series([]async.Routine{
+		async.Retry(func(done async.Done, args ...interface{}) {
+			attempts++
+			done(fatal)
+		}, async.RetryPolicy{
+			Retryable: func(err error) bool {
+				return err != fatal
+			},
+		}),
+	}, func(err error, results ...interface{}) {
+		if err != fatal {
+			t.Errorf("Expected %s, got %+v", fatal, err)
+			return
+		}
+
+		if attempts != 1 {
+			t.Errorf("Expected 1 attempt, got %d", attempts)
+		}
+	})
+}
+
+func TestRetryDoneCalledTwice(t *testing.T) {
+	attempts := 0
+
+	Status("Calling Series with a routine that fails twice in one attempt")
+	async.Series([]async.Routine{
+		async.Retry(func(done async.Done, args ...interface{}) {
+			attempts++
+			Status("Attempt %d", attempts)
+			if attempts == 1 {
+				done(fmt.Errorf("Test error"))
+				done(fmt.Errorf("Test error"))
+				return
+			}
+			done(nil)
+		}, async.RetryPolicy{
+			Attempts: 2,
+			Delay:    10 * time.Millisecond,
+		}),
+	}, func(err error, results ...interface{}) {
+		if err != nil {
+			t.Errorf("Unexpected error: %s", err)
+			return
+		}
+
+		if attempts != 2 {
+			t.Errorf("Expected 2 attempts, got %d", attempts)
+		}
+	})
+}
+
+func TestRetryMap(t *testing.T) {
+	ints := []int{1, 2, 3}
+	failed := make(map[int]bool)
+
+	mapper := async.Retry(func(done async.Done, args ...interface{}) {
+		// Fail the first attempt for every value.
+		if !failed[args[1].(int)] {
+			failed[args[1].(int)] = true
+			done(fmt.Errorf("Test error"))
+			return
+		}
+		done(nil, args[0].(int)*2)
+	}, async.RetryPolicy{Attempts: 2})
+
+	async.Map(ints, mapper, func(err error, results ...interface{}) {
+		if err != nil {
+			t.Errorf("Unexpected error: %s", err)
+			return
+		}
+
+		if len(results) != 3 || results[2] != 6 {
+			t.Errorf("Unexpected results: %+v", results)
+		}
+	})
+}
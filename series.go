@@ -1,5 +1,9 @@
 package async
 
+import (
+	"context"
+)
+
 /*
 
 Series is a shorthand function to List.RunSeries without having to manually
@@ -28,6 +32,59 @@ func SeriesParallel(routines []Routine, callbacks ...Done) {
 
 /*
 
+SeriesContext is a shorthand function to List.RunSeriesContext without having
+to manually create a new list, add the routines, etc.
+
+Each ContextRoutine is given a context that is derived from ctx. That context
+is cancelled as soon as one of the routines returns an error, or when ctx
+itself is cancelled.
+
+*/
+func SeriesContext(ctx context.Context, routines []ContextRoutine, callbacks ...Done) {
+	ctx, cancel := context.WithCancel(ctx)
+
+	l := New()
+	l.Multiple(withContext(ctx, routines)...)
+
+	l.runWaterfall(ctx, cancel, true, callbacks...)
+}
+
+/*
+
+SeriesParallelContext is a shorthand function to
+List.RunSeriesParallelContext without having to manually create a new list,
+add the routines, etc.
+
+Each ContextRoutine is given a context that is derived from ctx. That context
+is cancelled as soon as one of the routines returns an error, or when ctx
+itself is cancelled.
+
+*/
+func SeriesParallelContext(ctx context.Context, routines []ContextRoutine, callbacks ...Done) {
+	ctx, cancel := context.WithCancel(ctx)
+
+	l := New()
+	l.Multiple(withContext(ctx, routines)...)
+
+	l.discardArgs().runParallel(ctx, cancel, parallelMode{cancel: true}, callbacks...)
+}
+
+/*
+
+SeriesParallelSettled is a shorthand function to
+List.RunSeriesParallelSettled without having to manually create a new list,
+add the routines, etc.
+
+*/
+func SeriesParallelSettled(routines []Routine, callbacks ...Done) {
+	l := New()
+	l.Multiple(routines...)
+
+	l.RunSeriesParallelSettled(callbacks...)
+}
+
+/*
+
 RunSeries will run all of the Routine functions in a series effect.
 
 If there is an error, series will immediately exit and trigger the
@@ -80,12 +137,22 @@ For example, take a look at one of the tests for this function:
 
 */
 func (l *List) RunSeries(callbacks ...Done) {
-	fall := fallSeries(l, callbacks...)
-	next := nextSeries(l, callbacks...)
+	l.RunSeriesContext(context.Background(), callbacks...)
+}
+
+/*
+
+RunSeriesContext will run all of the Routine functions in a series effect, the
+same as RunSeries, but stops once ctx is cancelled.
 
-	l.Wait.Add(l.Len())
+If ctx is cancelled while a routine is running, the callbacks are triggered
+with the error from ctx straight away, and none of the remaining routines
+will be started.
 
-	fall(next)
+*/
+func (l *List) RunSeriesContext(ctx context.Context, callbacks ...Done) {
+	ctx, cancel := context.WithCancel(ctx)
+	l.runWaterfall(ctx, cancel, true, callbacks...)
 }
 
 /*
@@ -96,8 +163,8 @@ parallel mode.
 If there is an error, any further results will be discarded but it will not
 immediately exit. It will continue to run all of the other Routine functions
 that were passed into it. This is because by the time the error is sent, the
-goroutines have already been started. At this current time, there is no way
-to cancel a sleep timer in Go.
+goroutines have already been started. If you need your routines to be able
+to stop early, use RunSeriesParallelContext or SeriesParallelContext instead.
 
 There are no arguments passed between the routines that are used in series.
 It is just for commands that need to run asynchronously without seeing the
@@ -146,63 +213,55 @@ For example, take a look at one of the tests for this function:
 
 */
 func (l *List) RunSeriesParallel(callbacks ...Done) {
-	var routines []Routine
+	ctx, cancel := context.WithCancel(context.Background())
+	l.discardArgs().runParallel(ctx, cancel, parallelMode{}, callbacks...)
+}
 
-	for l.Len() > 0 {
-		e := l.Front()
-		_, r := l.Remove(e)
+/*
 
-		routines = append(routines, func(routine Routine) Routine {
-			return func(done Done, args ...interface{}) {
-				r(func(err error, args ...interface{}) {
-					// As with our normal RunSeries, we do not want to handle any args
-					// that are returned. We only want to return if an error occurred.
-					done(err)
-				})
-			}
-		}(r))
-	}
+RunSeriesParallelContext will run all of the Routine functions in a series
+effect, and in parallel mode, the same as RunSeriesParallel, but stops once
+ctx is cancelled.
 
-	l.Wait.Add(l.Len())
+More documentation on how cancellation is handled can be found on the
+RunParallelContext function.
 
-	Parallel(routines, callbacks...)
+*/
+func (l *List) RunSeriesParallelContext(ctx context.Context, callbacks ...Done) {
+	ctx, cancel := context.WithCancel(ctx)
+	l.discardArgs().runParallel(ctx, cancel, parallelMode{cancel: true}, callbacks...)
 }
 
-func fallSeries(l *List, callbacks ...Done) func(Done, ...interface{}) {
-	return func(next Done, args ...interface{}) {
-		e := l.Front()
-		_, r := l.Remove(e)
-
-		// Run the first series routine and give it the next function, and
-		// any arguments that were provided
-		go r(next)
-		l.Wait.Wait()
-	}
-}
+/*
 
-func nextSeries(l *List, callbacks ...Done) Done {
-	fall := fallSeries(l, callbacks...)
+RunSeriesParallelSettled will run all of the Routine functions in a series
+effect, and in parallel mode, but it waits for every Routine to finish instead
+of stopping at the first error.
 
-	return func(err error, args ...interface{}) {
-		next := nextSeries(l, callbacks...)
+If any of the routines returned an error, the callbacks are given an Errors
+value holding a *RoutineError for each of them. More documentation can be
+found on the RunParallelSettled function.
 
-		l.Wait.Done()
-		if err != nil || l.Len() == 0 {
-			// Just in case it's an error, let's make sure we've cleared
-			// all of the sync.WaitGroup waits that we initiated.
-			for i := 0; i < l.Len(); i++ {
-				l.Wait.Done()
-			}
+*/
+func (l *List) RunSeriesParallelSettled(callbacks ...Done) {
+	ctx, cancel := context.WithCancel(context.Background())
+	l.discardArgs().runParallel(ctx, cancel, parallelMode{all: true}, callbacks...)
+}
 
-			// Send the results to the callbacks
-			for i := 0; i < len(callbacks); i++ {
-				callbacks[i](err)
+// discardArgs wraps every Routine in the list so that only the error is sent
+// to its Done function.
+func (l *List) discardArgs() *List {
+	for e := l.Front(); e != nil; e = e.Next() {
+		e.Value = func(r Routine) Routine {
+			return func(done Done, args ...interface{}) {
+				r(func(err error, args ...interface{}) {
+					// As with our normal RunSeries, we do not want to handle any args
+					// that are returned. We only want to return if an error occurred.
+					done(err)
+				}, args...)
 			}
-			return
-		}
-
-		// Run the next series routine with any arguments that were provided
-		fall(next)
-		return
+		}(e.Value.(Routine))
 	}
+
+	return l
 }
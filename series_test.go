@@ -1,9 +1,11 @@
 package async_test
 
 import (
+	"context"
 	"fmt"
 	"github.com/Southern/async"
 	"testing"
+	"time"
 )
 
 func TestSeries(t *testing.T) {
@@ -153,3 +155,154 @@ func TestSeriesParallelError(t *testing.T) {
 		Status("Got error: %s", err)
 	})
 }
+
+func TestSeriesContextCancel(t *testing.T) {
+	var _err error
+
+	counter := 0
+	ctx, cancel := context.WithCancel(context.Background())
+
+	Status("Calling SeriesContext")
+	async.SeriesContext(ctx, []async.ContextRoutine{
+		func(ctx context.Context, done async.Done, args ...interface{}) {
+			Status("Increasing counter...")
+			counter++
+			done(nil)
+		},
+		func(ctx context.Context, done async.Done, args ...interface{}) {
+			Status("Cancelling context...")
+			cancel()
+			<-ctx.Done()
+			done(nil)
+		},
+		func(ctx context.Context, done async.Done, args ...interface{}) {
+			t.Errorf("Routine was started after the context was cancelled")
+			done(nil)
+		},
+	}, func(err error, results ...interface{}) {
+		_err = err
+	})
+
+	if _err != context.Canceled {
+		t.Errorf("Expected %s, got %+v", context.Canceled, _err)
+		return
+	}
+
+	if counter != 1 {
+		t.Errorf("Expected counter to be 1, got %d", counter)
+	}
+}
+
+func TestSeriesParallelContextError(t *testing.T) {
+	Status("Calling SeriesParallelContext")
+	async.SeriesParallelContext(context.Background(), []async.ContextRoutine{
+		func(ctx context.Context, done async.Done, args ...interface{}) {
+			Status("Waiting for cancellation...")
+			<-ctx.Done()
+			done(nil, "discarded")
+		},
+		func(ctx context.Context, done async.Done, args ...interface{}) {
+			Status("Sending error...")
+			done(fmt.Errorf("Test error"))
+		},
+	}, func(err error, results ...interface{}) {
+		if err == nil {
+			t.Errorf("Did not throw an error as expected")
+			return
+		}
+
+		Status("Got error: %s", err)
+	})
+}
+
+func TestSeriesParallelSettled(t *testing.T) {
+	counter := 0
+
+	Status("Calling SeriesParallelSettled")
+	async.SeriesParallelSettled([]async.Routine{
+		func(done async.Done, args ...interface{}) {
+			Status("Sending error...")
+			done(fmt.Errorf("Test error"))
+		},
+		func(done async.Done, args ...interface{}) {
+			Status("Increasing counter...")
+			time.Sleep(10 * time.Millisecond)
+			counter++
+			done(nil)
+		},
+	}, func(err error, results ...interface{}) {
+		if err == nil {
+			t.Errorf("Did not throw an error as expected")
+			return
+		}
+
+		Status("Got error: %s", err)
+	})
+
+	if counter != 1 {
+		t.Errorf("Not all routines were completed.")
+	}
+}
+
+func TestSeriesPanic(t *testing.T) {
+	Status("Calling Series")
+	async.Series([]async.Routine{
+		func(done async.Done, args ...interface{}) {
+			Status("Panicking...")
+			var m map[string]int
+			m["test"]++
+			done(nil)
+		},
+		func(done async.Done, args ...interface{}) {
+			t.Errorf("Series did not stop after a panic")
+			done(nil)
+		},
+	}, func(err error, results ...interface{}) {
+		if _, ok := err.(*async.PanicError); !ok {
+			t.Errorf("Expected a panic error, got %+v", err)
+			return
+		}
+
+		Status("Got error: %s", err)
+	})
+}
+
+func TestSeriesDoneCalledTwice(t *testing.T) {
+	Status("Calling Series")
+	async.Series([]async.Routine{
+		func(done async.Done, args ...interface{}) {
+			Status("Calling done twice...")
+			done(nil)
+			done(nil)
+		},
+		func(done async.Done, args ...interface{}) {
+			time.Sleep(10 * time.Millisecond)
+			done(nil)
+		},
+	}, func(err error, results ...interface{}) {
+		if err != async.ErrDoneCalledTwice {
+			t.Errorf("Expected %s, got %+v", async.ErrDoneCalledTwice, err)
+			return
+		}
+
+		Status("Got error: %s", err)
+	})
+}
+
+func TestSeriesDoneCalledTwiceLast(t *testing.T) {
+	Status("Calling Series")
+	async.Series([]async.Routine{
+		func(done async.Done, args ...interface{}) {
+			Status("Calling done twice...")
+			done(nil)
+			done(nil)
+		},
+	}, func(err error, results ...interface{}) {
+		if err != async.ErrDoneCalledTwice {
+			t.Errorf("Expected %s, got %+v", async.ErrDoneCalledTwice, err)
+			return
+		}
+
+		Status("Got error: %s", err)
+	})
+}
@@ -0,0 +1,125 @@
+package async
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+/*
+
+SortBy allows you to sort the data in a slice by a key that is worked out by a
+Routine. The keys are worked out in Parallel mode, the same as MapParallel.
+
+Each Routine will be called with the value and index of the current position
+in the slice, and passes the key for that value as the first argument to its
+Done function. Keys can be any kind of integer, float or string, but all of
+the keys must be the same kind. Values with the same key stay in the same
+order that they were in the slice.
+
+For example:
+  async.SortBy(users, func(done async.Done, args ...interface{}) {
+    age, err := lookupAge(args[0].(string))
+    done(err, age)
+  }, func(err error, results ...interface{}) {
+    if err != nil {
+      fmt.Printf("Error: %s", err)
+      return
+    }
+
+    fmt.Printf("Youngest first: %+v", results)
+  })
+
+*/
+func SortBy(data interface{}, routine Routine, callbacks ...Done) {
+	MapParallel(data, routine, func(err error, keys ...interface{}) {
+		var results []interface{}
+
+		if err == nil {
+			results, err = sortByKeys(data, keys)
+		}
+
+		for i := 0; i < len(callbacks); i++ {
+			if err != nil {
+				callbacks[i](err)
+			} else {
+				callbacks[i](err, results...)
+			}
+		}
+	})
+}
+
+// sortByKeys returns the values from data, sorted by the key at the same
+// index.
+func sortByKeys(data interface{}, keys []interface{}) ([]interface{}, error) {
+	var err error
+
+	d := reflect.ValueOf(data)
+
+	order := make([]int, len(keys))
+	for i := 0; i < len(order); i++ {
+		order[i] = i
+	}
+
+	sort.SliceStable(order, func(a, b int) bool {
+		less, e := compareKeys(keys[order[a]], keys[order[b]])
+		if e != nil && err == nil {
+			err = e
+		}
+		return less
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]interface{}, 0, len(order))
+	for i := 0; i < len(order); i++ {
+		results = append(results, d.Index(order[i]).Interface())
+	}
+
+	return results, nil
+}
+
+// compareKeys returns whether a is less than b. An error is returned if the
+// keys are not the same kind, or can't be compared.
+func compareKeys(a, b interface{}) (bool, error) {
+	va, vb := reflect.ValueOf(a), reflect.ValueOf(b)
+
+	switch {
+	case isInt(va) && isInt(vb):
+		return va.Int() < vb.Int(), nil
+	case isUint(va) && isUint(vb):
+		return va.Uint() < vb.Uint(), nil
+	case isFloat(va) && isFloat(vb):
+		return va.Float() < vb.Float(), nil
+	case va.Kind() == reflect.String && vb.Kind() == reflect.String:
+		return va.String() < vb.String(), nil
+	}
+
+	return false, fmt.Errorf("cannot sort by keys %T and %T", a, b)
+}
+
+func isInt(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return true
+	}
+	return false
+}
+
+func isUint(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return true
+	}
+	return false
+}
+
+func isFloat(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
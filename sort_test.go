@@ -0,0 +1,69 @@
+package async_test
+
+import (
+	"fmt"
+	"github.com/Southern/async"
+	"testing"
+	"time"
+)
+
+func TestSortBy(t *testing.T) {
+	str := []string{"ccc", "a", "bb", "dddd", "e"}
+
+	expects := []string{"a", "e", "bb", "ccc", "dddd"}
+
+	keyer := func(done async.Done, args ...interface{}) {
+		Status("Args: %+v", args)
+		// Make the first values finish last.
+		time.Sleep(time.Duration(len(str)-args[1].(int)) * time.Millisecond)
+		done(nil, len(args[0].(string)))
+	}
+
+	async.SortBy(str, keyer, func(err error, results ...interface{}) {
+		Status("Results: %+v", results)
+		if err != nil {
+			t.Errorf("Unexpected error: %s", err)
+			return
+		}
+
+		if len(results) != len(expects) {
+			t.Errorf("Expected %d results, got %d", len(expects), len(results))
+			return
+		}
+
+		for i := 0; i < len(expects); i++ {
+			if results[i] != expects[i] {
+				t.Errorf("Did not sort correctly: %+v", results)
+				break
+			}
+		}
+	})
+}
+
+func TestSortByMixedKeys(t *testing.T) {
+	keyer := func(done async.Done, args ...interface{}) {
+		if args[1] == 0 {
+			done(nil, "key")
+			return
+		}
+		done(nil, 1)
+	}
+
+	async.SortBy([]int{1, 2}, keyer, func(err error, results ...interface{}) {
+		if err == nil {
+			t.Errorf("Did not throw an error as expected")
+		}
+	})
+}
+
+func TestSortByError(t *testing.T) {
+	keyer := func(done async.Done, args ...interface{}) {
+		done(fmt.Errorf("Test error"))
+	}
+
+	async.SortBy([]int{1, 2}, keyer, func(err error, results ...interface{}) {
+		if err == nil {
+			t.Errorf("Did not throw an error as expected")
+		}
+	})
+}
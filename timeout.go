@@ -0,0 +1,76 @@
+package async
+
+import (
+	"sync"
+	"time"
+)
+
+/*
+
+Timeout wraps a Routine so that it fails with ErrTimeout if it hasn't called
+its Done function within the duration provided. This can be used to make sure
+that a Routine that never calls its Done function doesn't stall the rest of
+the routines forever.
+
+If the Routine calls its Done function after it has already timed out, the
+call is ignored.
+
+For example:
+  async.Series([]async.Routine{
+    async.Timeout(func(done async.Done, args ...interface{}) {
+      // Forgot to call done
+    }, time.Second),
+  }, func(err error, results ...interface{}) {
+    if err == async.ErrTimeout {
+      fmt.Println("The routine stalled")
+    }
+  })
+
+*/
+func Timeout(routine Routine, duration time.Duration) Routine {
+	return func(done Done, args ...interface{}) {
+		var (
+			mutex    sync.Mutex
+			finished bool
+			expired  bool
+		)
+
+		timer := time.AfterFunc(duration, func() {
+			mutex.Lock()
+			if finished {
+				mutex.Unlock()
+				return
+			}
+			expired = true
+			mutex.Unlock()
+
+			done(ErrTimeout)
+		})
+
+		// If the Routine panics, the timer has to be stopped before the
+		// panic is passed on, or it would report a timeout on top of it.
+		defer func() {
+			if v := recover(); v != nil {
+				mutex.Lock()
+				finished = true
+				mutex.Unlock()
+
+				timer.Stop()
+				panic(v)
+			}
+		}()
+
+		routine(func(err error, args ...interface{}) {
+			mutex.Lock()
+			if expired {
+				mutex.Unlock()
+				return
+			}
+			finished = true
+			mutex.Unlock()
+
+			timer.Stop()
+			done(err, args...)
+		}, args...)
+	}
+}
@@ -0,0 +1,85 @@
+package async_test
+
+import (
+	"errors"
+	"github.com/Southern/async"
+	"testing"
+	"time"
+)
+
+func TestTimeout(t *testing.T) {
+	Status("Calling Series with a stalled routine")
+	async.Series([]async.Routine{
+		async.Timeout(func(done async.Done, args ...interface{}) {
+			Status("Never calling done...")
+		}, 10*time.Millisecond),
+	}, func(err error, results ...interface{}) {
+		if err != async.ErrTimeout {
+			t.Errorf("Expected %s, got %+v", async.ErrTimeout, err)
+			return
+		}
+
+		Status("Got error: %s", err)
+	})
+}
+
+func TestTimeoutFinished(t *testing.T) {
+	Status("Calling Waterfall with a routine that finishes in time")
+	async.Waterfall([]async.Routine{
+		async.Timeout(func(done async.Done, args ...interface{}) {
+			done(nil, "arg1")
+		}, time.Second),
+	}, func(err error, results ...interface{}) {
+		if err != nil {
+			t.Errorf("Unexpected error: %s", err)
+			return
+		}
+
+		if len(results) != 1 || results[0] != "arg1" {
+			t.Errorf("Unexpected results: %+v", results)
+		}
+	})
+}
+
+func TestTimeoutLate(t *testing.T) {
+	Status("Calling Parallel with a routine that finishes late")
+	async.Parallel([]async.Routine{
+		async.Timeout(func(done async.Done, args ...interface{}) {
+			time.Sleep(20 * time.Millisecond)
+			done(nil, "arg1")
+		}, 10*time.Millisecond),
+	}, func(err error, results ...interface{}) {
+		if err != async.ErrTimeout {
+			t.Errorf("Expected %s, got %+v", async.ErrTimeout, err)
+		}
+	})
+
+	// Give the late call to done a chance to happen.
+	time.Sleep(20 * time.Millisecond)
+}
+
+func TestTimeoutPanic(t *testing.T) {
+	Status("Calling ParallelSettled with a routine that panics")
+	async.ParallelSettled([]async.Routine{
+		async.Timeout(func(done async.Done, args ...interface{}) {
+			panic("Test panic")
+		}, 20*time.Millisecond),
+		func(done async.Done, args ...interface{}) {
+			time.Sleep(60 * time.Millisecond)
+			done(nil)
+		},
+	}, func(err error, results ...interface{}) {
+		var panicked *async.PanicError
+		if !errors.As(err, &panicked) {
+			t.Errorf("Expected a *PanicError, got %+v", err)
+			return
+		}
+
+		if errors.Is(err, async.ErrDoneCalledTwice) || errors.Is(err, async.ErrTimeout) {
+			t.Errorf("Timed out after the panic was reported: %+v", err)
+			return
+		}
+
+		Status("Got error: %s", err)
+	})
+}
@@ -0,0 +1,71 @@
+package async
+
+/*
+
+Times will run routine n times in Parallel mode, and combine the results.
+
+Each Routine will be called with the index of the current run, from 0 to n-1.
+The results are handled the same as MapParallel, so they are kept in the
+order of the runs.
+
+For example, to create 5 users:
+  async.Times(5, func(done async.Done, args ...interface{}) {
+    user, err := createUser(fmt.Sprintf("user%d", args[0]))
+    done(err, user)
+  }, func(err error, results ...interface{}) {
+    if err != nil {
+      fmt.Printf("Error: %s", err)
+      return
+    }
+
+    fmt.Printf("Users: %+v", results)
+  })
+
+*/
+func Times(n int, routine Routine, callbacks ...Done) {
+	TimesLimit(n, 0, routine, callbacks...)
+}
+
+/*
+
+TimesSeries will run routine n times in Waterfall mode, the same as Times, but
+waiting for each run to finish before starting the next one. The results are
+handled the same as Map.
+
+*/
+func TimesSeries(n int, routine Routine, callbacks ...Done) {
+	Map(indexes(n), each(routine), callbacks...)
+}
+
+/*
+
+TimesLimit will run routine n times in Parallel mode, the same as Times, but
+with no more than limit runs at the same time. If limit is less than 1, all of
+the runs are started at once.
+
+*/
+func TimesLimit(n int, limit int, routine Routine, callbacks ...Done) {
+	MapParallelLimit(indexes(n), limit, each(routine), callbacks...)
+}
+
+// indexes returns a slice holding 0 to n-1
+func indexes(n int) []int {
+	if n < 0 {
+		n = 0
+	}
+
+	ids := make([]int, n)
+	for i := 0; i < n; i++ {
+		ids[i] = i
+	}
+
+	return ids
+}
+
+// each wraps routine so that it is only given the index when it is used with
+// Map.
+func each(routine Routine) Routine {
+	return func(done Done, args ...interface{}) {
+		routine(done, args[1])
+	}
+}
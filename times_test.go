@@ -0,0 +1,113 @@
+package async_test
+
+import (
+	"fmt"
+	"github.com/Southern/async"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestTimes(t *testing.T) {
+	Status("Calling Times")
+	async.Times(5, func(done async.Done, args ...interface{}) {
+		Status("Run %d", args[0])
+		// Make the first runs finish last.
+		time.Sleep(time.Duration(5-args[0].(int)) * 10 * time.Millisecond)
+		done(nil, args[0].(int)*2)
+	}, func(err error, results ...interface{}) {
+		if err != nil {
+			t.Errorf("Unexpected error: %s", err)
+			return
+		}
+
+		if len(results) != 5 {
+			t.Errorf("Expected 5 results, got %d", len(results))
+			return
+		}
+
+		for i := 0; i < len(results); i++ {
+			if results[i] != i*2 {
+				t.Errorf("Results were not kept in order: %+v", results)
+				break
+			}
+		}
+	})
+}
+
+func TestTimesSeries(t *testing.T) {
+	var order []int
+
+	Status("Calling TimesSeries")
+	async.TimesSeries(3, func(done async.Done, args ...interface{}) {
+		order = append(order, args[0].(int))
+		done(nil, fmt.Sprintf("run%d", args[0]))
+	}, func(err error, results ...interface{}) {
+		if err != nil {
+			t.Errorf("Unexpected error: %s", err)
+			return
+		}
+
+		if len(results) != 3 || results[2] != "run2" {
+			t.Errorf("Unexpected results: %+v", results)
+		}
+	})
+
+	if len(order) != 3 || order[0] != 0 || order[2] != 2 {
+		t.Errorf("Runs were not in order: %+v", order)
+	}
+}
+
+func TestTimesLimit(t *testing.T) {
+	var (
+		mutex   sync.Mutex
+		running int
+		most    int
+	)
+
+	Status("Calling TimesLimit")
+	async.TimesLimit(10, 2, func(done async.Done, args ...interface{}) {
+		mutex.Lock()
+		running++
+		if running > most {
+			most = running
+		}
+		mutex.Unlock()
+
+		time.Sleep(5 * time.Millisecond)
+
+		mutex.Lock()
+		running--
+		mutex.Unlock()
+
+		done(nil, args[0])
+	}, func(err error, results ...interface{}) {
+		if err != nil {
+			t.Errorf("Unexpected error: %s", err)
+			return
+		}
+
+		if len(results) != 10 {
+			t.Errorf("Expected 10 results, got %d", len(results))
+		}
+	})
+
+	if most > 2 {
+		t.Errorf("Expected at most 2 runs at once, got %d", most)
+	}
+}
+
+func TestTimesError(t *testing.T) {
+	Status("Calling Times")
+	async.Times(3, func(done async.Done, args ...interface{}) {
+		if args[0] == 1 {
+			done(fmt.Errorf("Test error"))
+			return
+		}
+		done(nil)
+	}, func(err error, results ...interface{}) {
+		if err == nil {
+			t.Errorf("Did not throw an error as expected")
+		}
+	})
+}
@@ -1,5 +1,9 @@
 package async
 
+import (
+	"context"
+)
+
 /*
 
 Waterfall is a shorthand function to List.RunWaterfall without having to
@@ -15,6 +19,25 @@ func Waterfall(routines []Routine, callbacks ...Done) {
 
 /*
 
+WaterfallContext is a shorthand function to List.RunWaterfallContext without
+having to manually create a new list, add the routines, etc.
+
+Each ContextRoutine is given a context that is derived from ctx. That context
+is cancelled as soon as one of the routines returns an error, or when ctx
+itself is cancelled.
+
+*/
+func WaterfallContext(ctx context.Context, routines []ContextRoutine, callbacks ...Done) {
+	ctx, cancel := context.WithCancel(ctx)
+
+	l := New()
+	l.Multiple(withContext(ctx, routines)...)
+
+	l.runWaterfall(ctx, cancel, false, callbacks...)
+}
+
+/*
+
 RunWaterfall runs all of the Routine functions in a waterfall effect.
 
 The arguments of the previous Routine function will be passed into the next
@@ -26,49 +49,82 @@ callbacks with the error.
 
 */
 func (l *List) RunWaterfall(callbacks ...Done) {
-	fall := fall(l, callbacks...)
-	next := nextWaterfall(l, callbacks...)
+	l.RunWaterfallContext(context.Background(), callbacks...)
+}
 
-	l.Wait.Add(l.Len())
+/*
 
-	fall(next)
+RunWaterfallContext runs all of the Routine functions in a waterfall effect,
+the same as RunWaterfall, but stops once ctx is cancelled.
+
+If ctx is cancelled while a routine is running, the callbacks are triggered
+with the error from ctx straight away, and none of the remaining routines
+will be started.
+
+*/
+func (l *List) RunWaterfallContext(ctx context.Context, callbacks ...Done) {
+	ctx, cancel := context.WithCancel(ctx)
+	l.runWaterfall(ctx, cancel, false, callbacks...)
 }
 
-func fall(l *List, callbacks ...Done) func(Done, ...interface{}) {
-	return func(next Done, args ...interface{}) {
+// runWaterfall runs the routines in the list one after another. When series
+// is true, the arguments from each routine are not passed into the next one,
+// and only the error is given to the callbacks.
+func (l *List) runWaterfall(ctx context.Context, cancel context.CancelFunc, series bool, callbacks ...Done) {
+	var (
+		err  error
+		args []interface{}
+
+		// late receives any errors from routines that have already finished,
+		// such as calling their Done function a second time.
+		late = make(chan error, 1)
+	)
+
+	defer cancel()
+
+	for l.Len() > 0 {
+		if err = ctx.Err(); err != nil {
+			args = nil
+			break
+		}
+
 		e := l.Front()
 		_, r := l.Remove(e)
 
-		// Run the first waterfall routine and give it the next function, and
-		// any arguments that were provided
-		go r(next, args...)
-		l.Wait.Wait()
+		// Run the next routine with any arguments that were provided by the
+		// previous one.
+		args, err = await(ctx, r, late, args...)
+		if err != nil {
+			cancel()
+			break
+		}
+
+		if series {
+			args = nil
+		}
 	}
-}
 
-func nextWaterfall(l *List, callbacks ...Done) Done {
-	fall := fall(l, callbacks...)
-
-	return func(err error, args ...interface{}) {
-		next := nextWaterfall(l, callbacks...)
-
-		l.Wait.Done()
-		if err != nil || l.Len() == 0 {
-			// Just in case it's an error, let's make sure we've cleared
-			// all of the sync.WaitGroup waits that we initiated.
-			for i := 0; i < l.Len(); i++ {
-				l.Wait.Done()
-			}
-
-			// Send the results to the callbacks
-			for i := 0; i < len(callbacks); i++ {
-				callbacks[i](err, args...)
-			}
-			return
+	// The last routine could have called its Done function a second time
+	// after sending its results. Pick that up if it has already happened,
+	// since there is nothing left to wait on.
+	if err == nil {
+		select {
+		case err = <-late:
+			args = nil
+		default:
 		}
+	}
+
+	// If we exited early, make sure none of the remaining routines can be
+	// ran again.
+	l.Init()
 
-		// Run the next waterfall routine with any arguments that were provided
-		fall(next, args...)
-		return
+	// Send the results to the callbacks
+	for i := 0; i < len(callbacks); i++ {
+		if series {
+			callbacks[i](err)
+		} else {
+			callbacks[i](err, args...)
+		}
 	}
 }
@@ -1,6 +1,7 @@
 package async_test
 
 import (
+	"context"
 	"fmt"
 	"github.com/Southern/async"
 	"testing"
@@ -69,3 +70,45 @@ func TestWaterfallError(t *testing.T) {
 		t.Errorf("Waterfall did not throw an error as expected")
 	})
 }
+
+func TestWaterfallContext(t *testing.T) {
+	Status("Calling WaterfallContext")
+	async.WaterfallContext(context.Background(), []async.ContextRoutine{
+		func(ctx context.Context, done async.Done, args ...interface{}) {
+			Status("First waterfall function")
+			done(nil, 1)
+		},
+
+		func(ctx context.Context, done async.Done, args ...interface{}) {
+			Status("Second waterfall function")
+			Status("Called with arguments: %+v", args)
+			done(nil, args[0].(int)+1)
+		},
+	}, func(err error, results ...interface{}) {
+		if err != nil {
+			t.Errorf("WaterfallContext threw an unexpected error: %+v", err)
+			return
+		}
+
+		if len(results) != 1 || results[0] != 2 {
+			t.Errorf("Unexpected results: %+v", results)
+		}
+	})
+}
+
+func TestWaterfallContextCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	Status("Calling WaterfallContext with a cancelled context")
+	async.WaterfallContext(ctx, []async.ContextRoutine{
+		func(ctx context.Context, done async.Done, args ...interface{}) {
+			t.Errorf("Routine was started with a cancelled context")
+			done(nil)
+		},
+	}, func(err error, results ...interface{}) {
+		if err != context.Canceled {
+			t.Errorf("Expected %s, got %+v", context.Canceled, err)
+		}
+	})
+}